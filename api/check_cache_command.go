@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/joho/godotenv"
+
+	"github.com/kkovaletp/photoview/api/database"
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner"
+	"github.com/kkovaletp/photoview/api/scanner/externaltools/exif"
+	"github.com/kkovaletp/photoview/api/scanner/media_cache"
+	"github.com/kkovaletp/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/kkovaletp/photoview/api/storage"
+)
+
+// checkCacheCommand is the argument running the media cache consistency check instead of the server.
+const checkCacheCommand = "check-cache"
+
+// runCheckCacheCommand reconciles the media cache with the database, like the `checkMediaCache` mutation,
+// but generates the missing files before returning. It returns the exit code of the process.
+func runCheckCacheCommand(args []string) int {
+	flags := flag.NewFlagSet(checkCacheCommand, flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "only report what would be done, without changing anything")
+	flags.Parse(args)
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found. If Photoview runs in Docker, this is expected and correct.")
+	}
+
+	terminateWorkers := executable_worker.Initialize()
+	defer terminateWorkers()
+
+	db, err := database.SetupDatabase()
+	if err != nil {
+		log.Printf("Could not connect to database: %s\n", err)
+		return 1
+	}
+
+	if err := database.MigrateDatabase(db); err != nil {
+		log.Printf("Could not migrate database: %s\n", err)
+		return 1
+	}
+
+	exifCleanup, err := exif.Initialize()
+	if err != nil {
+		log.Printf("Could not initialize exif parser: %s\n", err)
+		return 1
+	}
+	defer exifCleanup()
+
+	if err := storage.Initialize(); err != nil {
+		log.Printf("Could not initialize media storage: %s\n", err)
+		return 1
+	}
+
+	result, err := media_cache.CheckConsistency(db, *dryRun, func(media []*models.Media) error {
+		for i, m := range media {
+			log.Printf("Generating missing cache files of %s (%d/%d)\n", m.Path, i+1, len(media))
+			if err := scanner.ProcessSingleMedia(context.Background(), db, m); err != nil {
+				log.Printf("Could not generate missing cache files of %s: %s\n", m.Path, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Media cache check failed: %s\n", err)
+		return 1
+	}
+
+	log.Printf("Deleted %d orphaned files, reclaiming %d bytes\n", result.DeletedFiles, result.ReclaimedSize)
+	log.Printf("Found %d missing files, generated again for %d media\n", result.MissingFiles, result.QueuedMedia)
+	if *dryRun {
+		log.Println("Dry run, the media cache was not changed")
+	}
+
+	return 0
+}
@@ -171,6 +171,12 @@ var database_models []interface{} = []interface{}{
 	&models.UserMediaData{},
 	&models.UserAlbums{},
 	&models.UserPreferences{},
+	&models.MediaStack{},
+	&models.MediaEdit{},
+	&models.MediaXMP{},
+	&models.Tag{},
+	&models.MediaTag{},
+	&models.MediaPlace{},
 
 	// Face detection
 	&models.FaceGroup{},
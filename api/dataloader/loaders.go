@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/kkovaletp/photoview/api/graphql/models"
 	"gorm.io/gorm"
 )
 
@@ -16,8 +17,15 @@ type Loaders struct {
 	MediaThumbnail      *MediaURLLoader
 	MediaHighres        *MediaURLLoader
 	MediaVideoWeb       *MediaURLLoader
+	MediaVideoSprite    *MediaURLLoader
+	MediaVideoVTT       *MediaURLLoader
+	MediaVideoPreview   *MediaURLLoader
+	MediaMotionVideo    *MediaURLLoader
+	MediaPlace          *MediaPlaceLoader
+	MediaCaption        *MediaCaptionLoader
 	UserFromAccessToken *UserLoader
 	UserMediaFavorite   *UserFavoritesLoader
+	UserMediaData       *UserMediaDataLoader
 }
 
 func Middleware(db *gorm.DB) mux.MiddlewareFunc {
@@ -28,8 +36,15 @@ func Middleware(db *gorm.DB) mux.MiddlewareFunc {
 				MediaThumbnail:      NewThumbnailMediaURLLoader(db),
 				MediaHighres:        NewHighresMediaURLLoader(db),
 				MediaVideoWeb:       NewVideoWebMediaURLLoader(db),
+				MediaVideoSprite:    NewPurposeMediaURLLoader(db, models.VideoSprite),
+				MediaVideoVTT:       NewPurposeMediaURLLoader(db, models.VideoVTT),
+				MediaVideoPreview:   NewPurposeMediaURLLoader(db, models.VideoPreview),
+				MediaMotionVideo:    NewPurposeMediaURLLoader(db, models.MotionVideo),
+				MediaPlace:          NewMediaPlaceLoaderByMediaIDs(db),
+				MediaCaption:        NewMediaCaptionLoaderByMediaIDs(db),
 				UserFromAccessToken: NewUserLoaderByToken(db),
 				UserMediaFavorite:   NewUserFavoriteLoader(db),
+				UserMediaData:       NewUserMediaDataLoaderByIDs(db),
 			})
 
 			r = r.WithContext(ctx)
@@ -0,0 +1,45 @@
+package dataloader
+
+import (
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"gorm.io/gorm"
+)
+
+// NewMediaCaptionLoaderByMediaIDs loads the captions embedded in media by their ids, the description of their XMP
+// metadata or else the one of their EXIF metadata. Media without a description get nil.
+func NewMediaCaptionLoaderByMediaIDs(db *gorm.DB) *MediaCaptionLoader {
+	return &MediaCaptionLoader{
+		maxBatch: 100,
+		wait:     5 * time.Millisecond,
+		fetch: func(mediaIDs []int) ([]*string, []error) {
+			var rows []struct {
+				ID          int
+				Description *string
+			}
+
+			err := db.Model(&models.Media{}).
+				Select("media.id, COALESCE(media_xmp.description, media_exif.description) AS description").
+				Joins("LEFT JOIN media_xmp ON media_xmp.media_id = media.id").
+				Joins("LEFT JOIN media_exif ON media_exif.id = media.exif_id").
+				Where("media.id IN (?)", mediaIDs).
+				Scan(&rows).Error
+			if err != nil {
+				return nil, []error{err}
+			}
+
+			captionByMediaID := make(map[int]*string, len(rows))
+			for _, row := range rows {
+				captionByMediaID[row.ID] = row.Description
+			}
+
+			result := make([]*string, len(mediaIDs))
+			for i, mediaID := range mediaIDs {
+				result[i] = captionByMediaID[mediaID]
+			}
+
+			return result, nil
+		},
+	}
+}
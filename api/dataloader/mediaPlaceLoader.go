@@ -0,0 +1,34 @@
+package dataloader
+
+import (
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"gorm.io/gorm"
+)
+
+// NewMediaPlaceLoaderByMediaIDs loads the places of media by their ids. Media without a place get nil.
+func NewMediaPlaceLoaderByMediaIDs(db *gorm.DB) *MediaPlaceLoader {
+	return &MediaPlaceLoader{
+		maxBatch: 100,
+		wait:     5 * time.Millisecond,
+		fetch: func(mediaIDs []int) ([]*models.MediaPlace, []error) {
+			var places []*models.MediaPlace
+			if err := db.Where("media_id IN (?)", mediaIDs).Find(&places).Error; err != nil {
+				return nil, []error{err}
+			}
+
+			placeByMediaID := make(map[int]*models.MediaPlace, len(places))
+			for _, place := range places {
+				placeByMediaID[place.MediaID] = place
+			}
+
+			result := make([]*models.MediaPlace, len(mediaIDs))
+			for i, mediaID := range mediaIDs {
+				result[i] = placeByMediaID[mediaID]
+			}
+
+			return result, nil
+		},
+	}
+}
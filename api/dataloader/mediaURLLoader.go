@@ -80,3 +80,14 @@ func NewVideoWebMediaURLLoader(db *gorm.DB) *MediaURLLoader {
 		}),
 	}
 }
+
+// NewPurposeMediaURLLoader loads the media url of a single purpose, for purposes without any fallback
+func NewPurposeMediaURLLoader(db *gorm.DB, purpose models.MediaPurpose) *MediaURLLoader {
+	return &MediaURLLoader{
+		maxBatch: 100,
+		wait:     5 * time.Millisecond,
+		fetch: makeMediaURLLoader(db, func(query *gorm.DB) *gorm.DB {
+			return query.Where("purpose = ?", purpose)
+		}),
+	}
+}
@@ -0,0 +1,44 @@
+package dataloader
+
+import (
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"gorm.io/gorm"
+)
+
+// NewUserMediaDataLoaderByIDs loads the data of users about media, like their ratings.
+// Keys without saved data get an empty UserMediaData, with the ids of the key.
+func NewUserMediaDataLoaderByIDs(db *gorm.DB) *UserMediaDataLoader {
+	return &UserMediaDataLoader{
+		maxBatch: 100,
+		wait:     5 * time.Millisecond,
+		fetch: func(keys []*models.UserMediaData) ([]*models.UserMediaData, []error) {
+			userIDs := make([]int, 0, len(keys))
+			mediaIDs := make([]int, 0, len(keys))
+			for _, key := range keys {
+				userIDs = append(userIDs, key.UserID)
+				mediaIDs = append(mediaIDs, key.MediaID)
+			}
+
+			var userMediaData []*models.UserMediaData
+			err := db.Where("user_id IN (?)", userIDs).Where("media_id IN (?)", mediaIDs).Find(&userMediaData).Error
+			if err != nil {
+				return nil, []error{err}
+			}
+
+			result := make([]*models.UserMediaData, len(keys))
+			for i, key := range keys {
+				result[i] = &models.UserMediaData{UserID: key.UserID, MediaID: key.MediaID}
+				for _, data := range userMediaData {
+					if data.UserID == key.UserID && data.MediaID == key.MediaID {
+						result[i] = data
+						break
+					}
+				}
+			}
+
+			return result, nil
+		},
+	}
+}
@@ -1,6 +1,9 @@
 package actions
 
 import (
+	"strings"
+	"unicode/utf8"
+
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -37,7 +40,7 @@ func MyAlbums(db *gorm.DB, user *models.User, order *models.Ordering, paginate *
 
 	query = favoritesQuery(showEmpty, db, onlyWithFavorites, user, query)
 
-	query = models.FormatSQL(query, order, paginate)
+	query = models.FormatAlbumSQL(query, order, paginate)
 
 	var albums []*models.Album
 	if err := query.Find(&albums).Error; err != nil {
@@ -186,3 +189,33 @@ func ResetAlbumCover(db *gorm.DB, user *models.User, albumID int) (*models.Album
 
 	return &album, nil
 }
+
+// SetAlbumDetails replaces the custom title and the markdown description of an album.
+// A nil or blank title goes back to the name of the directory, a nil or blank description removes it.
+func SetAlbumDetails(db *gorm.DB, user *models.User, albumID int, title *string, description *string) (*models.Album, error) {
+	album, err := Album(db, user, albumID)
+	if err != nil {
+		return nil, err
+	}
+
+	title = optionalText(title)
+	if title != nil {
+		if strings.ContainsAny(*title, "\r\n") {
+			return nil, errors.New("album title must not contain line breaks")
+		}
+
+		if utf8.RuneCountInString(*title) > models.MaxAlbumTitleLength {
+			return nil, errors.Errorf("album title must not be longer than %d characters", models.MaxAlbumTitleLength)
+		}
+	}
+
+	description = optionalText(description)
+
+	if err := db.Model(album).Updates(map[string]any{"custom_title": title, "description": description}).Error; err != nil {
+		return nil, errors.Wrap(err, "update album details")
+	}
+	album.CustomTitle = title
+	album.Description = description
+
+	return album, nil
+}
@@ -380,3 +380,82 @@ func TestNonRootAlbumPathMultipleUsers(t *testing.T) {
 		assert.Equal(t, "child2", returnedAlbums[0].Title)
 	})
 }
+
+func TestSetAlbumDetails(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	user, err := models.RegisterUser(db, "user", nil, false)
+	assert.NoError(t, err)
+
+	anotherUser, err := models.RegisterUser(db, "user2", nil, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "2024-06 trip",
+		Path:  "/photos/2024-06 trip",
+	}
+
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	title := " Summer in Rome "
+	description := "Two weeks in **Rome**\n\nwith friends"
+	updated, err := actions.SetAlbumDetails(db, user, album.ID, &title, &description)
+	assert.NoError(t, err)
+	assert.Equal(t, "Summer in Rome", updated.DisplayTitle())
+	assert.Equal(t, description, *updated.Description)
+
+	var stored models.Album
+	assert.NoError(t, db.First(&stored, album.ID).Error)
+	assert.Equal(t, "Summer in Rome", stored.DisplayTitle())
+	assert.Equal(t, "2024-06 trip", stored.Title, "the title from the filesystem is kept")
+
+	_, err = actions.SetAlbumDetails(db, anotherUser, album.ID, &title, nil)
+	assert.Error(t, err, "albums of other users can't be changed")
+
+	multiline := "Summer\nin Rome"
+	_, err = actions.SetAlbumDetails(db, user, album.ID, &multiline, nil)
+	assert.Error(t, err)
+
+	blank := "  "
+	updated, err = actions.SetAlbumDetails(db, user, album.ID, &blank, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, updated.CustomTitle)
+	assert.Nil(t, updated.Description)
+	assert.Equal(t, "2024-06 trip", updated.DisplayTitle())
+}
+
+func TestMyAlbumsOrderByTitle(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+	boolFalse := false
+	boolTrue := true
+
+	user, err := models.RegisterUser(db, "user", nil, false)
+	assert.NoError(t, err)
+
+	customTitle := "Alps"
+	albums := []models.Album{
+		{Title: "b_album", Path: "/b_album"},
+		{Title: "c_album", Path: "/c_album", CustomTitle: &customTitle},
+		{Title: "d_album", Path: "/d_album"},
+	}
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&albums))
+
+	titles := func(albums []*models.Album) []string {
+		result := make([]string, len(albums))
+		for i, album := range albums {
+			result[i] = album.DisplayTitle()
+		}
+		return result
+	}
+
+	orderBy := models.TitleOrderBy
+	returnedAlbums, err := actions.MyAlbums(db, user, &models.Ordering{OrderBy: &orderBy}, nil, &boolFalse, &boolTrue, &boolFalse)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Alps", "b_album", "d_album"}, titles(returnedAlbums), "albums are sorted by their custom title")
+
+	desc := models.OrderDirectionDesc
+	returnedAlbums, err = actions.MyAlbums(db, user, &models.Ordering{OrderBy: &orderBy, OrderDirection: &desc}, nil, &boolFalse, &boolTrue, &boolFalse)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d_album", "b_album", "Alps"}, titles(returnedAlbums))
+}
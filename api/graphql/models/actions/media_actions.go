@@ -1,18 +1,28 @@
 package actions
 
 import (
+	"context"
+	"strings"
+
 	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/storage"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
-func MyMedia(db *gorm.DB, user *models.User, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+func MyMedia(db *gorm.DB, user *models.User, order *models.Ordering, paginate *models.Pagination, minRating *int) ([]*models.Media, error) {
 	if err := user.FillAlbums(db); err != nil {
 		return nil, err
 	}
 
 	query := db.Where("media.album_id IN (SELECT user_albums.album_id FROM user_albums WHERE user_albums.user_id = ?)",
 		user.ID)
-	query = models.FormatSQL(query, order, paginate)
+
+	if minRating != nil {
+		query = models.FilterMinRating(db, query, user.ID, *minRating)
+	}
+
+	query = models.FormatMediaSQL(query, user.ID, order, paginate)
 
 	var media []*models.Media
 	if err := query.Find(&media).Error; err != nil {
@@ -21,3 +31,109 @@ func MyMedia(db *gorm.DB, user *models.User, order *models.Ordering, paginate *m
 
 	return media, nil
 }
+
+// SetVideoPosterFrame stores the offset of the frame to use as the thumbnail of a video, or clears it if `timestamp` is nil.
+// The current thumbnail is removed, so it gets encoded from the new frame the next time the media is processed.
+func SetVideoPosterFrame(db *gorm.DB, user *models.User, mediaID int, timestamp *float64) (*models.Media, error) {
+	var media models.Media
+	if err := db.Preload("VideoMetadata").First(&media, mediaID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("media not found")
+		}
+		return nil, err
+	}
+
+	var album models.Album
+	if err := db.First(&album, media.AlbumID).Error; err != nil {
+		return nil, err
+	}
+
+	ownsAlbum, err := user.OwnsAlbum(db, &album)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ownsAlbum {
+		return nil, errors.New("forbidden")
+	}
+
+	if media.Type != models.MediaTypeVideo {
+		return nil, errors.New("media is not a video")
+	}
+
+	if media.VideoMetadata == nil {
+		return nil, errors.New("video metadata has not been scanned yet")
+	}
+
+	if timestamp != nil && (*timestamp < 0 || *timestamp > media.VideoMetadata.Duration) {
+		return nil, errors.Errorf("timestamp must be between 0 and %g seconds", media.VideoMetadata.Duration)
+	}
+
+	var thumbnails []*models.MediaURL
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(media.VideoMetadata).Update("poster_time", timestamp).Error; err != nil {
+			return errors.Wrap(err, "update video poster time")
+		}
+
+		if err := tx.Where("media_id = ? AND purpose = ?", media.ID, models.VideoThumbnail).Find(&thumbnails).Error; err != nil {
+			return errors.Wrap(err, "get current video thumbnail")
+		}
+
+		// A new thumbnail gets a new name, as clients are allowed to cache media urls forever
+		if err := tx.Where("media_id = ? AND purpose = ?", media.ID, models.VideoThumbnail).Delete(&models.MediaURL{}).Error; err != nil {
+			return errors.Wrap(err, "delete current video thumbnail")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, thumbnail := range thumbnails {
+		thumbnail.Media = &media
+		key, err := thumbnail.CacheKey()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := storage.Default().Delete(context.Background(), key); err != nil {
+			return nil, errors.Wrap(err, "remove current video thumbnail from cache")
+		}
+	}
+
+	media.VideoMetadata.PosterTime = timestamp
+
+	return &media, nil
+}
+
+// SetMediaCaption stores the caption of a media, or clears it if `caption` is nil or blank
+// so the description read from the file is shown again.
+func SetMediaCaption(db *gorm.DB, user *models.User, mediaID int, caption *string) (*models.Media, error) {
+	media, err := ownedMedia(db, user, []int{mediaID})
+	if err != nil {
+		return nil, err
+	}
+
+	caption = optionalText(caption)
+	if err := db.Model(media[0]).Update("caption", caption).Error; err != nil {
+		return nil, errors.Wrap(err, "update media caption")
+	}
+	media[0].Caption = caption
+
+	return media[0], nil
+}
+
+// optionalText trims `text`, returning nil if nothing is left.
+func optionalText(text *string) *string {
+	if text == nil {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*text)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
+}
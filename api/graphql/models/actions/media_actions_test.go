@@ -80,9 +80,44 @@ func TestMyMedia(t *testing.T) {
 	assert.NoError(t, db.Model(&anotherUser).Association("Albums").Append(&anotherAlbum))
 
 	t.Run("Simple query", func(t *testing.T) {
-		myMedia, err := actions.MyMedia(db, user, nil, nil)
+		myMedia, err := actions.MyMedia(db, user, nil, nil, nil)
 
 		assert.NoError(t, err)
 		assert.Len(t, myMedia, 4)
 	})
+
+	t.Run("Rating", func(t *testing.T) {
+		_, err := user.RateMedia(db, []int{media[0].ID}, 2)
+		assert.NoError(t, err)
+		_, err = user.RateMedia(db, []int{media[1].ID, media[2].ID}, 4)
+		assert.NoError(t, err)
+		_, err = user.RejectMedia(db, []int{media[2].ID}, true)
+		assert.NoError(t, err)
+
+		_, err = user.RateMedia(db, []int{anotherMedia.ID}, 5)
+		assert.Error(t, err, "media of other users can't be rated")
+		_, err = user.RateMedia(db, []int{media[0].ID}, 6)
+		assert.Error(t, err)
+
+		minRating := 3
+		myMedia, err := actions.MyMedia(db, user, nil, nil, &minRating)
+		assert.NoError(t, err)
+		assert.Len(t, myMedia, 1)
+		assert.Equal(t, media[1].ID, myMedia[0].ID)
+
+		minRating = 0
+		myMedia, err = actions.MyMedia(db, user, nil, nil, &minRating)
+		assert.NoError(t, err)
+		assert.Len(t, myMedia, 3, "rejected media are left out")
+
+		orderBy := models.RatingOrderBy
+		desc := models.OrderDirectionDesc
+		myMedia, err = actions.MyMedia(db, user, &models.Ordering{OrderBy: &orderBy, OrderDirection: &desc}, nil, nil)
+		assert.NoError(t, err)
+		ids := make([]int, len(myMedia))
+		for i, m := range myMedia {
+			ids[i] = m.ID
+		}
+		assert.Equal(t, []int{media[1].ID, media[0].ID, media[3].ID, media[2].ID}, ids)
+	})
 }
@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ShiftMediaDates moves the dates of media by `offset`, to correct a camera with a wrong clock or timezone.
+// Either `mediaIDs` or `albumID` must be given, for an album all media directly in it are shifted.
+func ShiftMediaDates(db *gorm.DB, user *models.User, mediaIDs []int, albumID *int, offset time.Duration) ([]*models.Media, error) {
+	return correctMediaDates(db, user, mediaIDs, albumID, func(media *models.Media) {
+		media.CorrectDateShot(media.DateShot.Add(offset))
+	})
+}
+
+// SetMediaDates sets the dates of media to `date`.
+// Either `mediaIDs` or `albumID` must be given, for an album all media directly in it are changed.
+func SetMediaDates(db *gorm.DB, user *models.User, mediaIDs []int, albumID *int, date time.Time) ([]*models.Media, error) {
+	return correctMediaDates(db, user, mediaIDs, albumID, func(media *models.Media) {
+		media.CorrectDateShot(date)
+	})
+}
+
+// ResetMediaDates undoes the date corrections of media, going back to the dates read from their files.
+// Either `mediaIDs` or `albumID` must be given, for an album all media directly in it are reset.
+func ResetMediaDates(db *gorm.DB, user *models.User, mediaIDs []int, albumID *int) ([]*models.Media, error) {
+	return correctMediaDates(db, user, mediaIDs, albumID, func(media *models.Media) {
+		media.ResetDateShot()
+	})
+}
+
+// correctMediaDates applies `correct` to the media of the user and saves their dates.
+func correctMediaDates(db *gorm.DB, user *models.User, mediaIDs []int, albumID *int, correct func(media *models.Media)) ([]*models.Media, error) {
+	media, err := dateCorrectionMedia(db, user, mediaIDs, albumID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range media {
+			correct(m)
+
+			if err := tx.Model(m).Select("date_shot", "original_date_shot").Updates(m).Error; err != nil {
+				return errors.Wrapf(err, "update date of media %d", m.ID)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// dateCorrectionMedia returns the media `mediaIDs` of the user, or the media directly in the album `albumID`.
+func dateCorrectionMedia(db *gorm.DB, user *models.User, mediaIDs []int, albumID *int) ([]*models.Media, error) {
+	if (len(mediaIDs) > 0) == (albumID != nil) {
+		return nil, errors.New("either media ids or an album id must be given")
+	}
+
+	if albumID == nil {
+		return ownedMedia(db, user, mediaIDs)
+	}
+
+	album, err := Album(db, user, *albumID)
+	if err != nil {
+		return nil, err
+	}
+
+	var media []*models.Media
+	if err := db.Where("album_id = ?", album.ID).Find(&media).Error; err != nil {
+		return nil, errors.Wrap(err, "get media of album")
+	}
+
+	return media, nil
+}
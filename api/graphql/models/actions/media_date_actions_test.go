@@ -0,0 +1,110 @@
+package actions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+	"github.com/kkovaletp/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMediaDateCorrection(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	user, err := models.RegisterUser(db, "user", nil, false)
+	assert.NoError(t, err)
+
+	anotherUser, err := models.RegisterUser(db, "user2", nil, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "trip",
+		Path:  "/photos/trip",
+	}
+
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	shot := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	media := []*models.Media{
+		{
+			Title:    "pic1",
+			Path:     "/photos/trip/pic1",
+			AlbumID:  album.ID,
+			DateShot: shot,
+		},
+		{
+			Title:    "pic2",
+			Path:     "/photos/trip/pic2",
+			AlbumID:  album.ID,
+			DateShot: shot.Add(time.Hour),
+		},
+	}
+
+	assert.NoError(t, db.Save(&media).Error)
+
+	dates := func() []time.Time {
+		var stored []*models.Media
+		assert.NoError(t, db.Order("id").Find(&stored).Error)
+
+		result := make([]time.Time, len(stored))
+		for i, m := range stored {
+			result[i] = m.DateShot.UTC()
+		}
+		return result
+	}
+
+	t.Run("Shift dates of album", func(t *testing.T) {
+		shifted, err := actions.ShiftMediaDates(db, user, nil, &album.ID, -9*time.Hour)
+		assert.NoError(t, err)
+		assert.Len(t, shifted, 2)
+		assert.Equal(t, []time.Time{shot.Add(-9 * time.Hour), shot.Add(-8 * time.Hour)}, dates())
+
+		_, err = actions.ShiftMediaDates(db, user, nil, &album.ID, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Time{shot.Add(-8 * time.Hour), shot.Add(-7 * time.Hour)}, dates())
+
+		var stored models.Media
+		assert.NoError(t, db.First(&stored, media[0].ID).Error)
+		assert.Equal(t, shot, stored.FileDateShot().UTC(), "the date of the file is kept")
+	})
+
+	t.Run("Set date of media", func(t *testing.T) {
+		date := time.Date(2024, 6, 2, 12, 0, 0, 0, time.UTC)
+		_, err := actions.SetMediaDates(db, user, []int{media[1].ID}, nil, date)
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Time{shot.Add(-8 * time.Hour), date}, dates())
+
+		_, err = actions.SetMediaDates(db, anotherUser, []int{media[1].ID}, nil, date)
+		assert.Error(t, err, "media of other users can't be changed")
+
+		_, err = actions.SetMediaDates(db, user, []int{media[1].ID}, &album.ID, date)
+		assert.Error(t, err, "either media or an album must be given")
+
+		_, err = actions.SetMediaDates(db, user, nil, nil, date)
+		assert.Error(t, err, "either media or an album must be given")
+	})
+
+	t.Run("Rescan keeps corrections", func(t *testing.T) {
+		var stored models.Media
+		assert.NoError(t, db.First(&stored, media[1].ID).Error)
+
+		stored.SetFileDateShot(shot.Add(2 * time.Hour))
+		assert.Equal(t, time.Date(2024, 6, 2, 12, 0, 0, 0, time.UTC), stored.DateShot.UTC())
+		assert.NoError(t, db.Save(&stored).Error)
+	})
+
+	t.Run("Reset dates", func(t *testing.T) {
+		reset, err := actions.ResetMediaDates(db, user, nil, &album.ID)
+		assert.NoError(t, err)
+		assert.Len(t, reset, 2)
+		assert.Nil(t, reset[0].OriginalDateShot)
+		assert.Equal(t, []time.Time{shot, shot.Add(2 * time.Hour)}, dates())
+
+		_, err = actions.ResetMediaDates(db, user, []int{media[0].ID}, nil)
+		assert.NoError(t, err, "media without corrections are left as they are")
+		assert.Equal(t, []time.Time{shot, shot.Add(2 * time.Hour)}, dates())
+	})
+}
@@ -0,0 +1,123 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/storage"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// EditMedia stores the non-destructive edits of a photo, replacing the previous ones.
+// The current thumbnail and high-res version are removed, so they get encoded from the edits
+// the next time the media is processed. The original file is left untouched.
+func EditMedia(db *gorm.DB, user *models.User, mediaID int, input models.MediaEditInput) (*models.Media, error) {
+	edit := models.MediaEdit{
+		Rotation:       input.Rotation,
+		FlipHorizontal: input.FlipHorizontal,
+		FlipVertical:   input.FlipVertical,
+		CropX:          input.CropX,
+		CropY:          input.CropY,
+		CropWidth:      input.CropWidth,
+		CropHeight:     input.CropHeight,
+		Exposure:       input.Exposure,
+		Contrast:       input.Contrast,
+	}
+
+	if err := edit.Validate(); err != nil {
+		return nil, err
+	}
+
+	return applyMediaEdit(db, user, mediaID, &edit)
+}
+
+// RevertMediaEdits removes the edits of a photo, so its derivatives get encoded from the original again.
+func RevertMediaEdits(db *gorm.DB, user *models.User, mediaID int) (*models.Media, error) {
+	return applyMediaEdit(db, user, mediaID, nil)
+}
+
+// applyMediaEdit replaces the edits of a photo with `edit`, or removes them if `edit` is nil.
+func applyMediaEdit(db *gorm.DB, user *models.User, mediaID int, edit *models.MediaEdit) (*models.Media, error) {
+	media, err := ownedMedia(db, user, []int{mediaID})
+	if err != nil {
+		return nil, err
+	}
+	photo := media[0]
+
+	if photo.Type != models.MediaTypePhoto {
+		return nil, errors.New("only photos can be edited")
+	}
+
+	var derivatives []*models.MediaURL
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var previous []*models.MediaEdit
+		if err := tx.Where("media_id = ?", photo.ID).Limit(1).Find(&previous).Error; err != nil {
+			return errors.Wrap(err, "get current edits of media")
+		}
+
+		var previousEdit *models.MediaEdit
+		if len(previous) > 0 {
+			previousEdit = previous[0]
+		}
+
+		if edit == nil {
+			if err := tx.Where("media_id = ?", photo.ID).Delete(&models.MediaEdit{}).Error; err != nil {
+				return errors.Wrap(err, "delete edits of media")
+			}
+		} else {
+			edit.MediaID = photo.ID
+			if previousEdit != nil {
+				edit.ID = previousEdit.ID
+				edit.CreatedAt = previousEdit.CreatedAt
+			}
+
+			if err := tx.Save(edit).Error; err != nil {
+				return errors.Wrap(err, "save edits of media")
+			}
+		}
+
+		// Faces are relative to the thumbnail, which is encoded from the edited image
+		var faces []*models.ImageFace
+		if err := tx.Where("media_id = ?", photo.ID).Find(&faces).Error; err != nil {
+			return errors.Wrap(err, "get faces of media")
+		}
+
+		for _, face := range faces {
+			rectangle := models.TransformFaceRectangle(face.Rectangle, previousEdit, edit)
+			if err := tx.Model(face).Update("rectangle", rectangle).Error; err != nil {
+				return errors.Wrap(err, "move face along with the edits of media")
+			}
+		}
+
+		purposes := []models.MediaPurpose{models.PhotoThumbnail, models.PhotoHighRes}
+		if err := tx.Where("media_id = ? AND purpose IN (?)", photo.ID, purposes).Find(&derivatives).Error; err != nil {
+			return errors.Wrap(err, "get current thumbnail and high-res of media")
+		}
+
+		// New derivatives get new names, as clients are allowed to cache media urls forever
+		err := tx.Where("media_id = ? AND purpose IN (?)", photo.ID, purposes).Delete(&models.MediaURL{}).Error
+		if err != nil {
+			return errors.Wrap(err, "delete current thumbnail and high-res of media")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, derivative := range derivatives {
+		derivative.Media = photo
+		key, err := derivative.CacheKey()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := storage.Default().Delete(context.Background(), key); err != nil {
+			return nil, errors.Wrap(err, "remove current derivative from cache")
+		}
+	}
+
+	return photo, nil
+}
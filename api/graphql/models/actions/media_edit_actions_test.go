@@ -0,0 +1,141 @@
+package actions_test
+
+import (
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+	"github.com/kkovaletp/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditMedia(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	password := "1234"
+	user, err := models.RegisterUser(db, "user", &password, false)
+	assert.NoError(t, err)
+
+	anotherUser, err := models.RegisterUser(db, "user2", &password, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "album",
+		Path:  "/photos",
+	}
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	photo := models.Media{
+		Title:   "photo.jpg",
+		Path:    "/photos/photo.jpg",
+		AlbumID: album.ID,
+		Type:    models.MediaTypePhoto,
+	}
+	assert.NoError(t, db.Save(&photo).Error)
+
+	thumbnail := models.MediaURL{
+		MediaID:   photo.ID,
+		MediaName: "thumbnail_photo_jpg_abcdef.jpg",
+		Purpose:   models.PhotoThumbnail,
+	}
+	original := models.MediaURL{
+		MediaID:   photo.ID,
+		MediaName: "photo.jpg",
+		Purpose:   models.MediaOriginal,
+	}
+	assert.NoError(t, db.Save(&thumbnail).Error)
+	assert.NoError(t, db.Save(&original).Error)
+
+	faceRectangle := models.FaceRectangle{MinX: 0.1, MaxX: 0.3, MinY: 0.2, MaxY: 0.5}
+	faceGroup := models.FaceGroup{
+		ImageFaces: []models.ImageFace{{
+			MediaID:   photo.ID,
+			Rectangle: faceRectangle,
+		}},
+	}
+	assert.NoError(t, db.Save(&faceGroup).Error)
+
+	getFaceRectangle := func(t *testing.T) models.FaceRectangle {
+		var face models.ImageFace
+		assert.NoError(t, db.Where("media_id = ?", photo.ID).First(&face).Error)
+		return face.Rectangle
+	}
+
+	t.Run("Invalid edit", func(t *testing.T) {
+		_, err := actions.EditMedia(db, user, photo.ID, models.MediaEditInput{
+			Rotation:   45,
+			CropWidth:  1,
+			CropHeight: 1,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Not owned media", func(t *testing.T) {
+		_, err := actions.EditMedia(db, anotherUser, photo.ID, models.MediaEditInput{
+			Rotation:   90,
+			CropWidth:  1,
+			CropHeight: 1,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Rotate photo", func(t *testing.T) {
+		_, err := actions.EditMedia(db, user, photo.ID, models.MediaEditInput{
+			Rotation:   90,
+			CropWidth:  1,
+			CropHeight: 1,
+			Exposure:   0.5,
+		})
+		assert.NoError(t, err)
+
+		var edit models.MediaEdit
+		assert.NoError(t, db.Where("media_id = ?", photo.ID).First(&edit).Error)
+		assert.Equal(t, 90, edit.Rotation)
+		assert.Equal(t, 0.5, edit.Exposure)
+
+		var purposes []models.MediaPurpose
+		assert.NoError(t, db.Model(&models.MediaURL{}).Where("media_id = ?", photo.ID).Pluck("purpose", &purposes).Error)
+		assert.ElementsMatch(t, []models.MediaPurpose{models.MediaOriginal}, purposes)
+
+		rect := getFaceRectangle(t)
+		assert.InDelta(t, 0.5, rect.MinX, 1e-5)
+		assert.InDelta(t, 0.8, rect.MaxX, 1e-5)
+		assert.InDelta(t, 0.1, rect.MinY, 1e-5)
+		assert.InDelta(t, 0.3, rect.MaxY, 1e-5)
+	})
+
+	t.Run("Replace edit", func(t *testing.T) {
+		_, err := actions.EditMedia(db, user, photo.ID, models.MediaEditInput{
+			Rotation:   180,
+			CropWidth:  1,
+			CropHeight: 1,
+		})
+		assert.NoError(t, err)
+
+		var count int64
+		assert.NoError(t, db.Model(&models.MediaEdit{}).Where("media_id = ?", photo.ID).Count(&count).Error)
+		assert.EqualValues(t, 1, count)
+
+		rect := getFaceRectangle(t)
+		assert.InDelta(t, 0.7, rect.MinX, 1e-5)
+		assert.InDelta(t, 0.9, rect.MaxX, 1e-5)
+		assert.InDelta(t, 0.5, rect.MinY, 1e-5)
+		assert.InDelta(t, 0.8, rect.MaxY, 1e-5)
+	})
+
+	t.Run("Revert edits", func(t *testing.T) {
+		_, err := actions.RevertMediaEdits(db, user, photo.ID)
+		assert.NoError(t, err)
+
+		var count int64
+		assert.NoError(t, db.Model(&models.MediaEdit{}).Where("media_id = ?", photo.ID).Count(&count).Error)
+		assert.EqualValues(t, 0, count)
+
+		rect := getFaceRectangle(t)
+		assert.InDelta(t, faceRectangle.MinX, rect.MinX, 1e-5)
+		assert.InDelta(t, faceRectangle.MaxX, rect.MaxX, 1e-5)
+		assert.InDelta(t, faceRectangle.MinY, rect.MinY, 1e-5)
+		assert.InDelta(t, faceRectangle.MaxY, rect.MaxY, 1e-5)
+	})
+}
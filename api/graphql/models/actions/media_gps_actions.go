@@ -0,0 +1,182 @@
+package actions
+
+import (
+	"math"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner/gpx"
+	"github.com/kkovaletp/photoview/api/scanner/media_date"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// DefaultGPXMaxGap is the longest time between a media and the track points its position is taken from,
+// if no other is given to GeotagAlbumFromGPX
+const DefaultGPXMaxGap = 30 * time.Minute
+
+// SetMediaCoordinates sets the GPS coordinates of the media `mediaIDs` of the user,
+// overriding the coordinates read from their files.
+func SetMediaCoordinates(db *gorm.DB, user *models.User, mediaIDs []int, latitude, longitude float64) ([]*models.Media, error) {
+	if math.IsNaN(latitude) || math.IsNaN(longitude) || math.Abs(latitude) > 90 || math.Abs(longitude) > 180 {
+		return nil, errors.New("latitude must be between -90 and 90 and longitude between -180 and 180")
+	}
+
+	return overrideMediaCoordinates(db, user, mediaIDs, func(exif *models.MediaEXIF) {
+		exif.OverrideGPS(&latitude, &longitude)
+	})
+}
+
+// ClearMediaCoordinates removes the GPS coordinates of the media `mediaIDs` of the user,
+// overriding the coordinates read from their files.
+func ClearMediaCoordinates(db *gorm.DB, user *models.User, mediaIDs []int) ([]*models.Media, error) {
+	return overrideMediaCoordinates(db, user, mediaIDs, func(exif *models.MediaEXIF) {
+		exif.OverrideGPS(nil, nil)
+	})
+}
+
+// ResetMediaCoordinates undoes the overrides of the GPS coordinates of the media `mediaIDs` of the user,
+// going back to the coordinates read from their files.
+func ResetMediaCoordinates(db *gorm.DB, user *models.User, mediaIDs []int) ([]*models.Media, error) {
+	return overrideMediaCoordinates(db, user, mediaIDs, func(exif *models.MediaEXIF) {
+		exif.ResetGPS()
+	})
+}
+
+// GeotagAlbumFromGPX sets the GPS coordinates of the media directly in the album `albumID` to the position of
+// `track` at the time they were shot, see gpx.Track.Locate. Media already having coordinates are skipped,
+// unless `overwrite` is set, as are media whose date has no time of day, see hasTimeShot.
+//
+// The dates of media are the local time of the camera, `offset` is the difference of its clock to UTC,
+// like 2 hours for UTC+2. If nil, the offset recorded in the EXIF metadata of each media is used, or none.
+func GeotagAlbumFromGPX(db *gorm.DB, user *models.User, albumID int, track *gpx.Track, offset *time.Duration,
+	maxGap time.Duration, overwrite bool) ([]*models.Media, error) {
+
+	if maxGap < 0 {
+		return nil, errors.New("max gap must not be negative")
+	}
+
+	album, err := Album(db, user, albumID)
+	if err != nil {
+		return nil, err
+	}
+
+	var media []*models.Media
+	if err := db.Preload("Exif").Where("album_id = ?", album.ID).Order("date_shot").Find(&media).Error; err != nil {
+		return nil, errors.Wrap(err, "get media of album")
+	}
+
+	geotagged := make([]*models.Media, 0)
+	positions := make(map[int]gpx.Point)
+	for _, m := range media {
+		if !hasTimeShot(m) {
+			continue
+		}
+
+		if !overwrite && m.Exif != nil && m.Exif.GPSLatitude != nil && m.Exif.GPSLongitude != nil {
+			continue
+		}
+
+		shotOffset := time.Duration(0)
+		if offset != nil {
+			shotOffset = *offset
+		} else if m.Exif != nil && m.Exif.OffsetSecShot != nil {
+			shotOffset = time.Duration(*m.Exif.OffsetSecShot) * time.Second
+		}
+
+		if point, ok := track.Locate(m.DateShot.Add(-shotOffset), maxGap); ok {
+			geotagged = append(geotagged, m)
+			positions[m.ID] = point
+		}
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range geotagged {
+			point := positions[m.ID]
+			err := saveMediaCoordinates(tx, m, func(exif *models.MediaEXIF) {
+				exif.OverrideGPS(&point.Latitude, &point.Longitude)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return geotagged, nil
+}
+
+// hasTimeShot tells if the date of the media includes the time it was shot, as read from its EXIF metadata
+// or its file name. Dates of folders, file names without a time and modification times of files are no such times.
+func hasTimeShot(media *models.Media) bool {
+	if media.DateSource == nil {
+		// The media was scanned before the source of its date was recorded, which was EXIF if it had a date
+		return media.Exif != nil && media.Exif.DateShot != nil
+	}
+
+	return media_date.HasTime(media.Path, *media.DateSource)
+}
+
+// overrideMediaCoordinates applies `override` to the EXIF metadata of the media of the user and saves their coordinates.
+func overrideMediaCoordinates(db *gorm.DB, user *models.User, mediaIDs []int, override func(exif *models.MediaEXIF)) ([]*models.Media, error) {
+	media, err := ownedMedia(db, user, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range media {
+			if err := saveMediaCoordinates(tx, m, override); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// saveMediaCoordinates applies `override` to the EXIF metadata of the media and saves its coordinates.
+// EXIF metadata is created for media without any.
+func saveMediaCoordinates(tx *gorm.DB, media *models.Media, override func(exif *models.MediaEXIF)) error {
+	if media.Exif == nil && media.ExifID != nil {
+		var exif models.MediaEXIF
+		if err := tx.First(&exif, *media.ExifID).Error; err != nil {
+			return errors.Wrapf(err, "get EXIF of media %d", media.ID)
+		}
+		media.Exif = &exif
+	}
+
+	if media.Exif == nil {
+		exif := models.MediaEXIF{}
+		if err := tx.Create(&exif).Error; err != nil {
+			return errors.Wrapf(err, "create EXIF of media %d", media.ID)
+		}
+
+		if err := tx.Model(media).Update("exif_id", exif.ID).Error; err != nil {
+			return errors.Wrapf(err, "link EXIF to media %d", media.ID)
+		}
+
+		media.ExifID = &exif.ID
+		media.Exif = &exif
+	}
+
+	override(media.Exif)
+
+	err := tx.Model(media.Exif).
+		Select("gps_latitude", "gps_longitude", "gps_overridden", "original_gps_latitude", "original_gps_longitude").
+		Updates(media.Exif).Error
+	if err != nil {
+		return errors.Wrapf(err, "update coordinates of media %d", media.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package actions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+	"github.com/kkovaletp/photoview/api/scanner/gpx"
+	"github.com/kkovaletp/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMediaCoordinates(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	user, err := models.RegisterUser(db, "user", nil, false)
+	require.NoError(t, err)
+
+	anotherUser, err := models.RegisterUser(db, "user2", nil, false)
+	require.NoError(t, err)
+
+	album := models.Album{
+		Title: "hike",
+		Path:  "/photos/hike",
+	}
+
+	require.NoError(t, db.Save(&album).Error)
+	require.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	latitude, longitude := 46.5, 8.0
+	offset := 7200
+	shot := time.Date(2024, 6, 1, 12, 5, 0, 0, time.UTC)
+	exifSource, filenameSource := models.MediaDateSourceExif, models.MediaDateSourceFilename
+	folderSource, modTimeSource := models.MediaDateSourceFolder, models.MediaDateSourceModTime
+
+	media := []*models.Media{
+		{Title: "tagged", Path: "/photos/hike/tagged.jpg", AlbumID: album.ID, DateShot: shot, DateSource: &exifSource,
+			Exif: &models.MediaEXIF{GPSLatitude: &latitude, GPSLongitude: &longitude, OffsetSecShot: &offset}},
+		{Title: "untagged", Path: "/photos/hike/untagged.jpg", AlbumID: album.ID, DateShot: shot, DateSource: &exifSource,
+			Exif: &models.MediaEXIF{OffsetSecShot: &offset}},
+		{Title: "no_exif", Path: "/photos/hike/IMG_20240601_120000.jpg", AlbumID: album.ID, DateShot: shot.Add(-5 * time.Minute),
+			DateSource: &filenameSource},
+		{Title: "late", Path: "/photos/hike/late.jpg", AlbumID: album.ID, DateShot: shot.Add(3 * time.Hour), DateSource: &exifSource},
+		{Title: "mod_time", Path: "/photos/hike/mod_time.jpg", AlbumID: album.ID, DateShot: shot, DateSource: &modTimeSource},
+		// Without a time of day, these would be placed on the track, as if they were shot in UTC
+		{Title: "folder", Path: "/photos/hike/folder.jpg", AlbumID: album.ID, DateShot: shot.Add(-2 * time.Hour),
+			DateSource: &folderSource},
+		{Title: "date_only", Path: "/photos/hike/IMG-20240601-WA0001.jpg", AlbumID: album.ID, DateShot: shot.Add(-2 * time.Hour),
+			DateSource: &filenameSource},
+		{Title: "legacy", Path: "/photos/hike/legacy.jpg", AlbumID: album.ID, DateShot: shot.Add(-2 * time.Hour)},
+	}
+
+	require.NoError(t, db.Save(&media).Error)
+
+	storedExif := func(m *models.Media) *models.MediaEXIF {
+		var stored models.Media
+		require.NoError(t, db.Preload("Exif").First(&stored, m.ID).Error)
+		return stored.Exif
+	}
+
+	t.Run("Set, clear and reset coordinates", func(t *testing.T) {
+		_, err := actions.SetMediaCoordinates(db, user, []int{media[0].ID}, 91, 0)
+		assert.Error(t, err, "latitude out of range")
+
+		_, err = actions.SetMediaCoordinates(db, anotherUser, []int{media[0].ID}, 1, 2)
+		assert.Error(t, err, "media of another user")
+
+		result, err := actions.SetMediaCoordinates(db, user, []int{media[0].ID, media[2].ID}, 1, 2)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+
+		exif := storedExif(media[0])
+		assert.Equal(t, 1.0, *exif.GPSLatitude)
+		assert.Equal(t, 2.0, *exif.GPSLongitude)
+		assert.True(t, exif.GPSOverridden)
+		assert.Equal(t, latitude, *exif.OriginalGPSLatitude)
+
+		exif = storedExif(media[2])
+		require.NotNil(t, exif, "EXIF is created for media without any")
+		assert.Equal(t, 1.0, *exif.GPSLatitude)
+		assert.Nil(t, exif.OriginalGPSLatitude)
+
+		_, err = actions.ClearMediaCoordinates(db, user, []int{media[0].ID})
+		require.NoError(t, err)
+
+		exif = storedExif(media[0])
+		assert.Nil(t, exif.GPSLatitude)
+		assert.Equal(t, latitude, *exif.OriginalGPSLatitude, "the coordinates of the file are kept")
+
+		_, err = actions.ResetMediaCoordinates(db, user, []int{media[0].ID, media[2].ID})
+		require.NoError(t, err)
+
+		exif = storedExif(media[0])
+		assert.Equal(t, latitude, *exif.GPSLatitude)
+		assert.Equal(t, longitude, *exif.GPSLongitude)
+		assert.False(t, exif.GPSOverridden)
+		assert.Nil(t, exif.OriginalGPSLatitude)
+
+		exif = storedExif(media[2])
+		assert.Nil(t, exif.GPSLatitude)
+		assert.False(t, exif.GPSOverridden)
+	})
+
+	track := &gpx.Track{Points: []gpx.Point{
+		{Time: time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC), Latitude: 47, Longitude: 9},
+		{Time: time.Date(2024, 6, 1, 10, 10, 0, 0, time.UTC), Latitude: 48, Longitude: 10},
+	}}
+
+	t.Run("Geotag album from GPX", func(t *testing.T) {
+		_, err := actions.GeotagAlbumFromGPX(db, anotherUser, album.ID, track, nil, actions.DefaultGPXMaxGap, false)
+		assert.Error(t, err, "album of another user")
+
+		result, err := actions.GeotagAlbumFromGPX(db, user, album.ID, track, nil, actions.DefaultGPXMaxGap, false)
+		require.NoError(t, err)
+		require.Equal(t, []int{media[1].ID}, mediaIDs(result), "only the media without coordinates within the track")
+
+		exif := storedExif(media[1])
+		assert.InDelta(t, 47.5, *exif.GPSLatitude, 1e-9, "the offset of the media is used")
+		assert.InDelta(t, 9.5, *exif.GPSLongitude, 1e-9)
+		assert.True(t, exif.GPSOverridden)
+
+		twoHours := 2 * time.Hour
+		result, err = actions.GeotagAlbumFromGPX(db, user, album.ID, track, &twoHours, 0, true)
+		require.NoError(t, err)
+		assert.Equal(t, []int{media[2].ID}, mediaIDs(result), "the given offset is used for all media")
+
+		result, err = actions.GeotagAlbumFromGPX(db, user, album.ID, track, nil, 10*time.Minute, true)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int{media[0].ID, media[1].ID}, mediaIDs(result), "existing coordinates are overwritten")
+		assert.Equal(t, 47.5, *storedExif(media[0]).GPSLatitude)
+		assert.Equal(t, latitude, *storedExif(media[0]).OriginalGPSLatitude)
+	})
+}
@@ -0,0 +1,149 @@
+package actions
+
+import (
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// StackMedia groups the media `mediaIDs` of a single album into a new manual stack.
+// The cover is `coverID`, or the first of `mediaIDs` if it is nil.
+func StackMedia(db *gorm.DB, user *models.User, mediaIDs []int, coverID *int) (*models.MediaStack, error) {
+	media, err := ownedMedia(db, user, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(media) < 2 {
+		return nil, errors.New("a stack needs at least two media")
+	}
+
+	albumID := media[0].AlbumID
+	for _, m := range media {
+		if m.AlbumID != albumID {
+			return nil, errors.New("stacked media must belong to the same album")
+		}
+	}
+
+	cover := mediaIDs[0]
+	if coverID != nil {
+		cover = *coverID
+		if !containsMediaID(media, cover) {
+			return nil, errors.New("the cover must be one of the stacked media")
+		}
+	}
+
+	stack := models.MediaStack{
+		AlbumID: albumID,
+		CoverID: cover,
+		Manual:  true,
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&stack).Error; err != nil {
+			return errors.Wrap(err, "create media stack")
+		}
+
+		previousStacks, err := moveToStack(tx, media, &stack.ID)
+		if err != nil {
+			return err
+		}
+
+		return models.RepairMediaStacks(tx, previousStacks)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stack.Members = media
+	return &stack, nil
+}
+
+// UnstackMedia takes the media `mediaIDs` out of their stacks, and keeps the scanner from stacking them again.
+func UnstackMedia(db *gorm.DB, user *models.User, mediaIDs []int) ([]*models.Media, error) {
+	media, err := ownedMedia(db, user, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		previousStacks, err := moveToStack(tx, media, nil)
+		if err != nil {
+			return err
+		}
+
+		return models.RepairMediaStacks(tx, previousStacks)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// moveToStack puts `media` in the stack `stackID`, or out of any stack if it is nil.
+// It returns the stacks the media were in before.
+func moveToStack(tx *gorm.DB, media []*models.Media, stackID *int) ([]int, error) {
+	ids := make([]int, len(media))
+	previousStacks := make([]int, 0)
+	for i, m := range media {
+		ids[i] = m.ID
+		if m.StackID != nil {
+			previousStacks = append(previousStacks, *m.StackID)
+		}
+
+		m.StackID = stackID
+		m.StackExcluded = stackID == nil
+	}
+
+	err := tx.Model(&models.Media{}).Where("id IN (?)", ids).Updates(map[string]any{
+		"stack_id":       stackID,
+		"stack_excluded": stackID == nil,
+	}).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "update stack of media")
+	}
+
+	return previousStacks, nil
+}
+
+// ownedMedia returns the media `mediaIDs`, if all of them exist and belong to albums owned by `user`.
+func ownedMedia(db *gorm.DB, user *models.User, mediaIDs []int) ([]*models.Media, error) {
+	var media []*models.Media
+	if err := db.Where("id IN (?)", mediaIDs).Find(&media).Error; err != nil {
+		return nil, err
+	}
+
+	uniqueIDs := make(map[int]struct{}, len(mediaIDs))
+	for _, id := range mediaIDs {
+		uniqueIDs[id] = struct{}{}
+	}
+
+	if len(media) != len(uniqueIDs) {
+		return nil, errors.New("media not found")
+	}
+
+	checkedAlbums := make(map[int]struct{})
+	for _, m := range media {
+		if _, ok := checkedAlbums[m.AlbumID]; ok {
+			continue
+		}
+
+		if _, err := Album(db, user, m.AlbumID); err != nil {
+			return nil, err
+		}
+		checkedAlbums[m.AlbumID] = struct{}{}
+	}
+
+	return media, nil
+}
+
+func containsMediaID(media []*models.Media, id int) bool {
+	for _, m := range media {
+		if m.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,83 @@
+package actions_test
+
+import (
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+	"github.com/kkovaletp/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackMedia(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	password := "1234"
+	user, err := models.RegisterUser(db, "user", &password, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "root",
+		Path:  "/photos",
+	}
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	otherAlbum := models.Album{
+		Title: "other",
+		Path:  "/other",
+	}
+	assert.NoError(t, db.Save(&otherAlbum).Error)
+
+	media := []models.Media{
+		{Title: "pic1", Path: "/photos/pic1", AlbumID: album.ID},
+		{Title: "pic2", Path: "/photos/pic2", AlbumID: album.ID},
+		{Title: "pic3", Path: "/photos/pic3", AlbumID: album.ID},
+		{Title: "pic4", Path: "/other/pic4", AlbumID: otherAlbum.ID},
+	}
+	assert.NoError(t, db.Save(&media).Error)
+
+	t.Run("Forbidden", func(t *testing.T) {
+		_, err := actions.StackMedia(db, user, []int{media[0].ID, media[3].ID}, nil)
+		assert.EqualError(t, err, "forbidden")
+	})
+
+	t.Run("CoverNotInStack", func(t *testing.T) {
+		_, err := actions.StackMedia(db, user, []int{media[0].ID, media[1].ID}, &media[2].ID)
+		assert.Error(t, err)
+	})
+
+	stack, err := actions.StackMedia(db, user, []int{media[0].ID, media[1].ID, media[2].ID}, &media[1].ID)
+	assert.NoError(t, err)
+	assert.True(t, stack.Manual)
+	assert.Equal(t, media[1].ID, stack.CoverID)
+
+	var albumMedia []*models.Media
+	assert.NoError(t, models.OnlyStackCovers(db, db.Where("album_id = ?", album.ID)).Find(&albumMedia).Error)
+	assert.Len(t, albumMedia, 1)
+	assert.Equal(t, media[1].ID, albumMedia[0].ID)
+
+	t.Run("UnstackCover", func(t *testing.T) {
+		unstacked, err := actions.UnstackMedia(db, user, []int{media[1].ID})
+		assert.NoError(t, err)
+		assert.Len(t, unstacked, 1)
+		assert.True(t, unstacked[0].StackExcluded)
+
+		var updated models.MediaStack
+		assert.NoError(t, db.First(&updated, stack.ID).Error)
+		assert.Equal(t, media[0].ID, updated.CoverID)
+	})
+
+	t.Run("UnstackDissolvesStack", func(t *testing.T) {
+		_, err := actions.UnstackMedia(db, user, []int{media[2].ID})
+		assert.NoError(t, err)
+
+		var count int64
+		assert.NoError(t, db.Model(&models.MediaStack{}).Where("id = ?", stack.ID).Count(&count).Error)
+		assert.EqualValues(t, 0, count)
+
+		var remaining models.Media
+		assert.NoError(t, db.First(&remaining, media[0].ID).Error)
+		assert.Nil(t, remaining.StackID)
+	})
+}
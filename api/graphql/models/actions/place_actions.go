@@ -0,0 +1,142 @@
+package actions
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// placeCount is the number of media of a user shot at a city, or in a region or country outside of cities
+type placeCount struct {
+	CountryCode string
+	Country     *string
+	Region      *string
+	City        *string
+	MediaCount  int
+	CoverID     int
+}
+
+// MyPlaces returns the countries where media of the user were shot ordered by name,
+// with their regions and cities as children.
+func MyPlaces(db *gorm.DB, user *models.User) ([]*models.Place, error) {
+	var counts []*placeCount
+	err := db.Model(&models.MediaPlace{}).
+		Select("media_places.country_code, media_places.country, media_places.region, media_places.city, "+
+			"COUNT(*) AS media_count, MAX(media_places.media_id) AS cover_id").
+		Joins("JOIN media ON media.id = media_places.media_id").
+		Where("media.album_id IN (?)", db.Table("user_albums").Select("user_albums.album_id").Where("user_albums.user_id = ?", user.ID)).
+		Where("media_places.country_code IS NOT NULL").
+		Group("media_places.country_code, media_places.country, media_places.region, media_places.city").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "get places of user")
+	}
+
+	countries := make([]*models.Place, 0)
+	for _, count := range counts {
+		countryName := count.CountryCode
+		if count.Country != nil {
+			countryName = *count.Country
+		}
+
+		country := placeChild(&countries, models.PlaceLevelCountry, countryName, countryName, count)
+		addPlaceCount(country, count)
+
+		parent := country
+		if count.Region != nil {
+			parent = placeChild(&country.Children, models.PlaceLevelRegion, *count.Region, countryName, count)
+			addPlaceCount(parent, count)
+		}
+
+		if count.City != nil {
+			addPlaceCount(placeChild(&parent.Children, models.PlaceLevelCity, *count.City, countryName, count), count)
+		}
+	}
+
+	sortPlaces(countries)
+	return countries, nil
+}
+
+// PlaceMedia returns the media of the user shot at `place`.
+func PlaceMedia(db *gorm.DB, user *models.User, place *models.PlaceFilter, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+	query := db.Where("media.album_id IN (?)", db.Table("user_albums").Select("user_albums.album_id").Where("user_albums.user_id = ?", user.ID))
+	query = PlaceMediaQuery(db, query, place)
+
+	var media []*models.Media
+	if err := models.FormatMediaSQL(query, user.ID, order, paginate).Find(&media).Error; err != nil {
+		return nil, errors.Wrap(err, "get media of place")
+	}
+
+	return media, nil
+}
+
+// PlaceMediaQuery limits a media query to media shot at `place`.
+func PlaceMediaQuery(db *gorm.DB, query *gorm.DB, place *models.PlaceFilter) *gorm.DB {
+	places := db.Model(&models.MediaPlace{}).
+		Select("1").
+		Where("media_places.media_id = media.id").
+		Where("media_places.country_code = ?", strings.ToUpper(strings.TrimSpace(place.CountryCode)))
+
+	if place.Region != nil {
+		places = places.Where("media_places.region = ?", *place.Region)
+	}
+
+	if place.City != nil {
+		places = places.Where("media_places.city = ?", *place.City)
+	}
+
+	return query.Where("EXISTS (?)", places)
+}
+
+// placeChild returns the place `name` at `level` in `places`, adding it if it is missing.
+// Countries are matched by their code, as their names may differ between versions of the gazetteer.
+func placeChild(places *[]*models.Place, level models.PlaceLevel, name string, country string, count *placeCount) *models.Place {
+	for _, place := range *places {
+		if level == models.PlaceLevelCountry && place.CountryCode == count.CountryCode {
+			return place
+		}
+
+		if level != models.PlaceLevelCountry && place.Level == level && place.Name == name {
+			return place
+		}
+	}
+
+	place := &models.Place{
+		Level:       level,
+		Name:        name,
+		CountryCode: count.CountryCode,
+		Country:     country,
+		Children:    make([]*models.Place, 0),
+	}
+
+	if level != models.PlaceLevelCountry {
+		place.Region = count.Region
+	}
+
+	if level == models.PlaceLevelCity {
+		place.City = count.City
+	}
+
+	*places = append(*places, place)
+	return place
+}
+
+func addPlaceCount(place *models.Place, count *placeCount) {
+	place.MediaCount += count.MediaCount
+	if count.CoverID > place.CoverID {
+		place.CoverID = count.CoverID
+	}
+}
+
+func sortPlaces(places []*models.Place) {
+	sort.Slice(places, func(i, j int) bool {
+		return places[i].Name < places[j].Name
+	})
+
+	for _, place := range places {
+		sortPlaces(place.Children)
+	}
+}
@@ -0,0 +1,123 @@
+package actions_test
+
+import (
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_tasks"
+	"github.com/kkovaletp/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaces(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	user, err := models.RegisterUser(db, "user", nil, false)
+	require.NoError(t, err)
+
+	anotherUser, err := models.RegisterUser(db, "user2", nil, false)
+	require.NoError(t, err)
+
+	album := models.Album{
+		Title: "italy",
+		Path:  "/photos/italy",
+	}
+
+	require.NoError(t, db.Save(&album).Error)
+	require.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	coordinates := func(latitude, longitude float64) *models.MediaEXIF {
+		return &models.MediaEXIF{GPSLatitude: &latitude, GPSLongitude: &longitude}
+	}
+
+	media := []*models.Media{
+		{Title: "colosseum", Path: "/photos/italy/colosseum.jpg", AlbumID: album.ID, Exif: coordinates(41.8902, 12.4922)},
+		{Title: "trevi", Path: "/photos/italy/trevi.jpg", AlbumID: album.ID, Exif: coordinates(41.9009, 12.4833)},
+		{Title: "duomo", Path: "/photos/italy/duomo.jpg", AlbumID: album.ID, Exif: coordinates(43.7731, 11.2560)},
+		{Title: "ferry", Path: "/photos/italy/ferry.jpg", AlbumID: album.ID, Exif: coordinates(35.0, -30.0)},
+		{Title: "indoors", Path: "/photos/italy/indoors.jpg", AlbumID: album.ID},
+	}
+
+	require.NoError(t, db.Save(&media).Error)
+	require.NoError(t, scanner_tasks.UpdateAlbumPlaces(db, album.ID))
+
+	var mediaPlaces []*models.MediaPlace
+	require.NoError(t, db.Order("media_id").Find(&mediaPlaces).Error)
+	require.Len(t, mediaPlaces, 4, "places are stored for all geotagged media")
+	assert.Nil(t, mediaPlaces[3].CountryCode, "the open sea has no place")
+
+	t.Run("My places", func(t *testing.T) {
+		places, err := actions.MyPlaces(db, user)
+		require.NoError(t, err)
+		require.Len(t, places, 1)
+
+		italy := places[0]
+		assert.Equal(t, models.PlaceLevelCountry, italy.Level)
+		assert.Equal(t, "Italy", italy.Name)
+		assert.Equal(t, "IT", italy.CountryCode)
+		assert.Equal(t, 3, italy.MediaCount)
+		assert.Equal(t, media[2].ID, italy.CoverID)
+
+		require.Len(t, italy.Children, 2)
+		assert.Equal(t, "Lazio", italy.Children[0].Name)
+		assert.Equal(t, "Tuscany", italy.Children[1].Name)
+		assert.Equal(t, 2, italy.Children[0].MediaCount)
+
+		require.Len(t, italy.Children[0].Children, 1)
+		rome := italy.Children[0].Children[0]
+		assert.Equal(t, models.PlaceLevelCity, rome.Level)
+		assert.Equal(t, "Rome", rome.Name)
+		assert.Equal(t, "Lazio", *rome.Region)
+		assert.Equal(t, 2, rome.MediaCount)
+		assert.Equal(t, media[1].ID, rome.CoverID)
+
+		places, err = actions.MyPlaces(db, anotherUser)
+		require.NoError(t, err)
+		assert.Empty(t, places)
+	})
+
+	t.Run("Search by place", func(t *testing.T) {
+		region := "Lazio"
+		result, err := actions.Search(db, "", user.ID, nil, nil, nil, &models.PlaceFilter{CountryCode: "it", Region: &region})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int{media[0].ID, media[1].ID}, mediaIDs(result.Media))
+
+		result, err = actions.Search(db, "duomo", user.ID, nil, nil, nil, &models.PlaceFilter{CountryCode: "IT"})
+		require.NoError(t, err)
+		assert.Equal(t, []int{media[2].ID}, mediaIDs(result.Media))
+
+		result, err = actions.Search(db, "", anotherUser.ID, nil, nil, nil, &models.PlaceFilter{CountryCode: "IT"})
+		require.NoError(t, err)
+		assert.Empty(t, result.Media)
+	})
+
+	t.Run("Changed coordinates", func(t *testing.T) {
+		latitude, longitude := 48.8584, 2.2945
+		require.NoError(t, db.Model(media[2].Exif).Updates(models.MediaEXIF{GPSLatitude: &latitude, GPSLongitude: &longitude}).Error)
+		require.NoError(t, db.Model(media[0].Exif).Select("gps_latitude", "gps_longitude").
+			Updates(models.MediaEXIF{}).Error)
+
+		require.NoError(t, scanner_tasks.UpdateAlbumPlaces(db, album.ID))
+
+		places, err := actions.MyPlaces(db, user)
+		require.NoError(t, err)
+		require.Len(t, places, 2)
+		assert.Equal(t, "France", places[0].Name)
+		assert.Equal(t, "Italy", places[1].Name)
+		assert.Equal(t, 1, places[1].MediaCount, "media without coordinates lose their place")
+
+		placeMedia, err := actions.PlaceMedia(db, user, places[0].Children[0].Children[0].Filter(), nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []int{media[2].ID}, mediaIDs(placeMedia))
+	})
+}
+
+func mediaIDs(media []*models.Media) []int {
+	ids := make([]int, len(media))
+	for i, m := range media {
+		ids[i] = m.ID
+	}
+	return ids
+}
@@ -10,7 +10,11 @@ import (
 	"gorm.io/gorm/clause"
 )
 
-func Search(db *gorm.DB, query string, userID int, limitMedia *int, limitAlbums *int) (*models.SearchResult, error) {
+// Search finds the media and albums of the user matching `query`, in their titles, paths, captions and descriptions.
+// With `tags`, only media tagged with all of the tag paths, or tags below them, are found.
+// With `place`, only media shot at the place are found.
+func Search(db *gorm.DB, query string, userID int, limitMedia *int, limitAlbums *int, tags []string,
+	place *models.PlaceFilter) (*models.SearchResult, error) {
 	limitMediaInternal := 10
 	limitAlbumsInternal := 10
 
@@ -33,13 +37,27 @@ func Search(db *gorm.DB, query string, userID int, limitMedia *int, limitAlbums
 		userSubquery = userSubquery.Where("album_id = Album.id")
 	}
 
-	err := db.Joins("Album").
+	mediaQuery := db.Joins("Album").
 		Where("EXISTS (?)", userSubquery).
-		Where("(LOWER(media.title) LIKE ? OR LOWER(media.path) LIKE ?)", wildQuery, wildQuery).
+		Where("(LOWER(media.title) LIKE ? OR LOWER(media.path) LIKE ? OR LOWER(media.caption) LIKE ?)",
+			wildQuery, wildQuery, wildQuery)
+
+	for _, tag := range tags {
+		var err error
+		if mediaQuery, err = TagMediaQuery(db, mediaQuery, userID, tag); err != nil {
+			return nil, err
+		}
+	}
+
+	if place != nil {
+		mediaQuery = PlaceMediaQuery(db, mediaQuery, place)
+	}
+
+	err := mediaQuery.
 		Clauses(clause.OrderBy{
 			Expression: clause.Expr{
-				SQL:                "(CASE WHEN LOWER(media.title) LIKE ? THEN 2 WHEN LOWER(media.path) LIKE ? THEN 1 END) DESC",
-				Vars:               []interface{}{wildQuery, wildQuery},
+				SQL:                "(CASE WHEN LOWER(media.title) LIKE ? THEN 3 WHEN LOWER(media.caption) LIKE ? THEN 2 WHEN LOWER(media.path) LIKE ? THEN 1 END) DESC",
+				Vars:               []interface{}{wildQuery, wildQuery, wildQuery},
 				WithoutParentheses: true},
 		}).
 		Limit(limitMediaInternal).Find(&media).Error
@@ -52,11 +70,12 @@ func Search(db *gorm.DB, query string, userID int, limitMedia *int, limitAlbums
 
 	err = db.
 		Where("EXISTS (?)", db.Table("user_albums").Where("user_id = ?", userID).Where("album_id = albums.id")).
-		Where("(albums.title LIKE ? OR albums.path LIKE ?)", wildQuery, wildQuery).
+		Where("(albums.title LIKE ? OR albums.path LIKE ? OR LOWER(albums.custom_title) LIKE ? OR LOWER(albums.description) LIKE ?)",
+			wildQuery, wildQuery, wildQuery, wildQuery).
 		Clauses(clause.OrderBy{
 			Expression: clause.Expr{
-				SQL:                "(CASE WHEN albums.title LIKE ? THEN 2 WHEN albums.path LIKE ? THEN 1 END) DESC",
-				Vars:               []interface{}{wildQuery, wildQuery},
+				SQL:                "(CASE WHEN LOWER(albums.custom_title) LIKE ? THEN 4 WHEN albums.title LIKE ? THEN 3 WHEN LOWER(albums.description) LIKE ? THEN 2 WHEN albums.path LIKE ? THEN 1 END) DESC",
+				Vars:               []interface{}{wildQuery, wildQuery, wildQuery, wildQuery},
 				WithoutParentheses: true},
 		}).
 		Limit(limitAlbumsInternal).
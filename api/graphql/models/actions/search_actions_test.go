@@ -91,7 +91,7 @@ func TestSearch(t *testing.T) {
 
 	for _, test := range searchTests {
 		t.Run(fmt.Sprintf("Search query: '%s'", test.query), func(t *testing.T) {
-			result, err := actions.Search(db, test.query, test.userID, test.limitMedia, test.limitAlbum)
+			result, err := actions.Search(db, test.query, test.userID, test.limitMedia, test.limitAlbum, nil, nil)
 			assert.NoError(t, err)
 
 			assert.Equal(t, result.Query, test.query)
@@ -100,3 +100,56 @@ func TestSearch(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchCaptionsAndDescriptions(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	user, err := models.RegisterUser(db, "user", nil, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "2024-06",
+		Path:  "/photos/2024-06",
+	}
+
+	assert.NoError(t, db.Create(&album).Error)
+	assert.NoError(t, db.Model(&album).Association("Owners").Append(user))
+
+	media := models.Media{
+		Title:   "IMG_0001.jpg",
+		Path:    "/photos/2024-06/IMG_0001.jpg",
+		AlbumID: album.ID,
+	}
+
+	assert.NoError(t, db.Create(&media).Error)
+
+	caption := "Sunset over the Colosseum"
+	_, err = actions.SetMediaCaption(db, user, media.ID, &caption)
+	assert.NoError(t, err)
+
+	title := "Holidays"
+	description := "A week in *Rome*"
+	_, err = actions.SetAlbumDetails(db, user, album.ID, &title, &description)
+	assert.NoError(t, err)
+
+	result, err := actions.Search(db, "colosseum", user.ID, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, result.Media, 1)
+	assert.Empty(t, result.Albums)
+
+	result, err = actions.Search(db, "holidays", user.ID, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Media)
+	assert.Len(t, result.Albums, 1)
+
+	result, err = actions.Search(db, "rome", user.ID, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, result.Albums, 1)
+
+	_, err = actions.SetMediaCaption(db, user, media.ID, nil)
+	assert.NoError(t, err)
+
+	result, err = actions.Search(db, "colosseum", user.ID, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Media)
+}
@@ -0,0 +1,331 @@
+package actions
+
+import (
+	"path"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RemovedTags are tags removed from media by UntagMedia, DeleteTag or RenameTag
+type RemovedTags struct {
+	// Media are the media which had the tags
+	Media []*models.Media
+	Paths []string
+}
+
+// MyTags returns the tags of the user ordered by path, with their media counts.
+func MyTags(db *gorm.DB, user *models.User) ([]*models.Tag, error) {
+	var tags []*models.Tag
+	if err := db.Where("user_id = ?", user.ID).Order("path").Find(&tags).Error; err != nil {
+		return nil, errors.Wrap(err, "get tags of user")
+	}
+
+	if err := models.FillTagMediaCounts(db, user.ID, tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// Tag returns the tag `tagID` of the user.
+func Tag(db *gorm.DB, user *models.User, tagID int) (*models.Tag, error) {
+	var tag models.Tag
+	if err := db.Where("id = ? AND user_id = ?", tagID, user.ID).First(&tag).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("tag not found")
+		}
+		return nil, errors.Wrap(err, "get tag")
+	}
+
+	return &tag, nil
+}
+
+// CreateTag creates the tag at `tagPath` for the user with its missing parents, or returns it if it exists already.
+func CreateTag(db *gorm.DB, user *models.User, tagPath string) (*models.Tag, error) {
+	tagPath, err := models.NormalizeTagPath(tagPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var tag *models.Tag
+	err = db.Transaction(func(tx *gorm.DB) error {
+		tag, err = models.FindOrCreateTag(tx, user.ID, tagPath)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// RenameTag moves the tag `tagID` of the user to `tagPath` together with the tags below it.
+// The missing parents of the new path are created, an existing tag is never overwritten.
+func RenameTag(db *gorm.DB, user *models.User, tagID int, tagPath string) (*models.Tag, *RemovedTags, error) {
+	tag, err := Tag(db, user, tagID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tagPath, err = models.NormalizeTagPath(tagPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if tagPath == tag.Path {
+		return tag, &RemovedTags{}, nil
+	}
+
+	if (&models.Tag{Path: tagPath}).IsBelow(tag.Path) {
+		return nil, nil, errors.New("a tag can't be moved below itself")
+	}
+
+	subtree, err := tagsBelow(db, user, tag.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newPaths := make([]string, len(subtree))
+	for i, t := range subtree {
+		newPaths[i] = tagPath + t.Path[len(tag.Path):]
+	}
+
+	var existing int64
+	if err := db.Model(&models.Tag{}).Where("user_id = ? AND path IN (?)", user.ID, newPaths).Count(&existing).Error; err != nil {
+		return nil, nil, errors.Wrap(err, "check for existing tags")
+	}
+
+	if existing > 0 {
+		return nil, nil, errors.Errorf("tag %s already exists", tagPath)
+	}
+
+	removed, err := removedTags(db, subtree)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var parentID *int
+		if parentPath := path.Dir(tagPath); parentPath != "." {
+			parent, err := models.FindOrCreateTag(tx, user.ID, parentPath)
+			if err != nil {
+				return err
+			}
+			parentID = &parent.ID
+		}
+
+		for i, t := range subtree {
+			updates := map[string]any{"path": newPaths[i]}
+			if t.ID == tag.ID {
+				updates["name"] = path.Base(tagPath)
+				updates["parent_id"] = parentID
+			}
+
+			if err := tx.Model(&models.Tag{}).Where("id = ?", t.ID).Updates(updates).Error; err != nil {
+				return errors.Wrapf(err, "rename tag %s", t.Path)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag, err = Tag(db, user, tagID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tag, removed, nil
+}
+
+// DeleteTag deletes the tag `tagID` of the user and the tags below it, removing them from their media.
+func DeleteTag(db *gorm.DB, user *models.User, tagID int) (*models.Tag, *RemovedTags, error) {
+	tag, err := Tag(db, user, tagID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subtree, err := tagsBelow(db, user, tag.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	removed, err := removedTags(db, subtree)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tagIDs := make([]int, len(subtree))
+	for i, t := range subtree {
+		tagIDs[i] = t.ID
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id IN (?)", tagIDs).Delete(&models.MediaTag{}).Error; err != nil {
+			return errors.Wrap(err, "remove tags from media")
+		}
+
+		if err := tx.Where("id IN (?)", tagIDs).Delete(&models.Tag{}).Error; err != nil {
+			return errors.Wrap(err, "delete tags")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tag, removed, nil
+}
+
+// TagMedia tags the media `mediaIDs` of the user with the tag at `tagPath`, which is created if it doesn't exist.
+func TagMedia(db *gorm.DB, user *models.User, mediaIDs []int, tagPath string) (*models.Tag, []*models.Media, error) {
+	media, err := ownedMedia(db, user, mediaIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tagPath, err = models.NormalizeTagPath(tagPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tag *models.Tag
+	err = db.Transaction(func(tx *gorm.DB) error {
+		tag, err = models.FindOrCreateTag(tx, user.ID, tagPath)
+		if err != nil {
+			return err
+		}
+
+		if len(media) == 0 {
+			return nil
+		}
+
+		mediaTags := make([]*models.MediaTag, len(media))
+		for i, m := range media {
+			mediaTags[i] = &models.MediaTag{TagID: tag.ID, MediaID: m.ID}
+		}
+
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&mediaTags).Error; err != nil {
+			return errors.Wrap(err, "tag media")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tag, media, nil
+}
+
+// UntagMedia removes the tag `tagID` of the user from the media `mediaIDs`, tags below it are kept.
+func UntagMedia(db *gorm.DB, user *models.User, mediaIDs []int, tagID int) (*RemovedTags, error) {
+	media, err := ownedMedia(db, user, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := Tag(db, user, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Where("tag_id = ? AND media_id IN (?)", tag.ID, mediaIDs).Delete(&models.MediaTag{}).Error; err != nil {
+		return nil, errors.Wrap(err, "untag media")
+	}
+
+	return &RemovedTags{Media: media, Paths: []string{tag.Path}}, nil
+}
+
+// MediaTags returns the tags the user gave the media, ordered by path.
+func MediaTags(db *gorm.DB, user *models.User, mediaID int) ([]*models.Tag, error) {
+	var tags []*models.Tag
+	if err := db.Joins("JOIN media_tags ON media_tags.tag_id = tags.id").
+		Where("media_tags.media_id = ? AND tags.user_id = ?", mediaID, user.ID).
+		Order("tags.path").
+		Find(&tags).Error; err != nil {
+		return nil, errors.Wrap(err, "get tags of media")
+	}
+
+	return tags, nil
+}
+
+// TaggedMedia returns the media of the user tagged with `tag` or a tag below it.
+func TaggedMedia(db *gorm.DB, user *models.User, tag *models.Tag, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+	query := db.Where("media.album_id IN (?)", db.Table("user_albums").Select("user_albums.album_id").Where("user_albums.user_id = ?", user.ID))
+
+	query, err := TagMediaQuery(db, query, user.ID, tag.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var media []*models.Media
+	if err := models.FormatMediaSQL(query, user.ID, order, paginate).Find(&media).Error; err != nil {
+		return nil, errors.Wrap(err, "get tagged media")
+	}
+
+	return media, nil
+}
+
+// TagMediaQuery limits a media query to media tagged by the user `userID` with the tag at `tagPath` or one below it.
+// No media are left if the user has no such tag.
+func TagMediaQuery(db *gorm.DB, query *gorm.DB, userID int, tagPath string) (*gorm.DB, error) {
+	tagPath, err := models.NormalizeTagPath(tagPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs, err := models.TagIDsBelow(db, userID, tagPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tagIDs) == 0 {
+		return query.Where("1 = 0"), nil
+	}
+
+	return query.Where("EXISTS (?)", db.Model(&models.MediaTag{}).
+		Select("1").
+		Where("media_tags.media_id = media.id").
+		Where("media_tags.tag_id IN (?)", tagIDs)), nil
+}
+
+// tagsBelow returns the tags of the user at `tagPath` and below it.
+func tagsBelow(db *gorm.DB, user *models.User, tagPath string) ([]*models.Tag, error) {
+	var userTags []*models.Tag
+	if err := db.Where("user_id = ?", user.ID).Order("path").Find(&userTags).Error; err != nil {
+		return nil, errors.Wrap(err, "get tags of user")
+	}
+
+	subtree := make([]*models.Tag, 0)
+	for _, t := range userTags {
+		if t.IsBelow(tagPath) {
+			subtree = append(subtree, t)
+		}
+	}
+
+	return subtree, nil
+}
+
+// removedTags returns the media tagged with any of `tags`, which lose them.
+func removedTags(db *gorm.DB, tags []*models.Tag) (*RemovedTags, error) {
+	removed := RemovedTags{Paths: make([]string, len(tags))}
+	tagIDs := make([]int, len(tags))
+	for i, t := range tags {
+		removed.Paths[i] = t.Path
+		tagIDs[i] = t.ID
+	}
+
+	if err := db.Where("media.id IN (?)", db.Model(&models.MediaTag{}).Select("media_tags.media_id").Where("media_tags.tag_id IN (?)", tagIDs)).
+		Find(&removed.Media).Error; err != nil {
+		return nil, errors.Wrap(err, "get tagged media")
+	}
+
+	return &removed, nil
+}
@@ -0,0 +1,177 @@
+package actions_test
+
+import (
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+	"github.com/kkovaletp/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTags(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	password := "1234"
+	user, err := models.RegisterUser(db, "user", &password, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "album",
+		Path:  "/photos",
+	}
+
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	media := []models.Media{
+		{
+			Title:   "rome",
+			Path:    "/photos/rome.jpg",
+			AlbumID: album.ID,
+		},
+		{
+			Title:   "milan",
+			Path:    "/photos/milan.jpg",
+			AlbumID: album.ID,
+		},
+		{
+			Title:   "paris",
+			Path:    "/photos/paris.jpg",
+			AlbumID: album.ID,
+		},
+	}
+
+	assert.NoError(t, db.Save(&media).Error)
+
+	anotherUser, err := models.RegisterUser(db, "user2", &password, false)
+	assert.NoError(t, err)
+
+	anotherAlbum := models.Album{
+		Title: "another",
+		Path:  "/another",
+	}
+
+	assert.NoError(t, db.Save(&anotherAlbum).Error)
+	assert.NoError(t, db.Model(&anotherUser).Association("Albums").Append(&anotherAlbum))
+
+	anotherMedia := models.Media{
+		Title:   "anotherPic",
+		Path:    "/another/anotherPic",
+		AlbumID: anotherAlbum.ID,
+	}
+
+	assert.NoError(t, db.Save(&anotherMedia).Error)
+
+	tagPaths := func(tags []*models.Tag) []string {
+		paths := make([]string, len(tags))
+		for i, tag := range tags {
+			paths[i] = tag.Path
+		}
+		return paths
+	}
+
+	t.Run("Tag media", func(t *testing.T) {
+		tag, tagged, err := actions.TagMedia(db, user, []int{media[0].ID}, " Places / Italy/Rome/ ")
+		assert.NoError(t, err)
+		assert.Equal(t, "Places/Italy/Rome", tag.Path)
+		assert.Equal(t, "Rome", tag.Name)
+		assert.Len(t, tagged, 1)
+
+		_, _, err = actions.TagMedia(db, user, []int{media[0].ID, media[1].ID}, "Places/Italy")
+		assert.NoError(t, err)
+		_, _, err = actions.TagMedia(db, user, []int{media[2].ID}, "Places/France")
+		assert.NoError(t, err)
+
+		_, _, err = actions.TagMedia(db, user, []int{anotherMedia.ID}, "Places")
+		assert.Error(t, err, "media of other users can't be tagged")
+		_, _, err = actions.TagMedia(db, user, []int{media[0].ID}, " / ")
+		assert.Error(t, err)
+
+		tags, err := actions.MyTags(db, user)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Places", "Places/France", "Places/Italy", "Places/Italy/Rome"}, tagPaths(tags))
+
+		counts := make([]int, len(tags))
+		for i, tag := range tags {
+			counts[i] = *tag.MediaCount
+		}
+		assert.Equal(t, []int{3, 1, 2, 1}, counts)
+
+		mediaTags, err := actions.MediaTags(db, user, media[0].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Places/Italy", "Places/Italy/Rome"}, tagPaths(mediaTags))
+
+		anotherTags, err := actions.MyTags(db, anotherUser)
+		assert.NoError(t, err)
+		assert.Empty(t, anotherTags, "tags are kept per user")
+	})
+
+	t.Run("Search by tag", func(t *testing.T) {
+		result, err := actions.Search(db, "", user.ID, nil, nil, []string{"Places/Italy"}, nil)
+		assert.NoError(t, err)
+		assert.Len(t, result.Media, 2)
+
+		result, err = actions.Search(db, "", user.ID, nil, nil, []string{"Places/Italy", "Places/Italy/Rome"}, nil)
+		assert.NoError(t, err)
+		assert.Len(t, result.Media, 1)
+		assert.Equal(t, media[0].ID, result.Media[0].ID)
+
+		result, err = actions.Search(db, "", anotherUser.ID, nil, nil, []string{"Places"}, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, result.Media)
+	})
+
+	t.Run("Rename tag", func(t *testing.T) {
+		var tag models.Tag
+		assert.NoError(t, db.Where("user_id = ? AND path = ?", user.ID, "Places/Italy").First(&tag).Error)
+
+		_, _, err = actions.RenameTag(db, user, tag.ID, "Places/Italy/Rome/Italy")
+		assert.Error(t, err, "a tag can't be moved below itself")
+		_, _, err = actions.RenameTag(db, user, tag.ID, "Places/France")
+		assert.Error(t, err, "an existing tag can't be overwritten")
+
+		renamed, removed, err := actions.RenameTag(db, user, tag.ID, "Countries/Italia")
+		assert.NoError(t, err)
+		assert.Equal(t, "Countries/Italia", renamed.Path)
+		assert.Equal(t, "Italia", renamed.Name)
+		assert.Equal(t, []string{"Places/Italy", "Places/Italy/Rome"}, removed.Paths)
+		assert.Len(t, removed.Media, 2)
+
+		tags, err := actions.MyTags(db, user)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Countries", "Countries/Italia", "Countries/Italia/Rome", "Places", "Places/France"}, tagPaths(tags))
+
+		var rome models.Tag
+		assert.NoError(t, db.Where("user_id = ? AND path = ?", user.ID, "Countries/Italia/Rome").Preload("Parent").First(&rome).Error)
+		assert.Equal(t, renamed.ID, rome.Parent.ID)
+	})
+
+	t.Run("Untag and delete tag", func(t *testing.T) {
+		var italia models.Tag
+		assert.NoError(t, db.Where("user_id = ? AND path = ?", user.ID, "Countries/Italia").First(&italia).Error)
+
+		removed, err := actions.UntagMedia(db, user, []int{media[1].ID}, italia.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Countries/Italia"}, removed.Paths)
+
+		taggedMedia, err := actions.TaggedMedia(db, user, &italia, nil, nil)
+		assert.NoError(t, err)
+		assert.Len(t, taggedMedia, 1)
+
+		_, _, err = actions.DeleteTag(db, anotherUser, italia.ID)
+		assert.Error(t, err, "tags of other users can't be deleted")
+
+		_, removed, err = actions.DeleteTag(db, user, italia.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Countries/Italia", "Countries/Italia/Rome"}, removed.Paths)
+
+		tags, err := actions.MyTags(db, user)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Countries", "Places", "Places/France"}, tagPaths(tags))
+
+		mediaTags, err := actions.MediaTags(db, user, media[0].ID)
+		assert.NoError(t, err)
+		assert.Empty(t, mediaTags)
+	})
+}
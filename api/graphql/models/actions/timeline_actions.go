@@ -8,7 +8,7 @@ import (
 	"gorm.io/gorm"
 )
 
-func MyTimeline(db *gorm.DB, user *models.User, paginate *models.Pagination, onlyFavorites *bool,
+func MyTimeline(db *gorm.DB, user *models.User, paginate *models.Pagination, onlyFavorites *bool, minRating *int,
 	fromDate *time.Time) ([]*models.Media, error) {
 
 	const albumsTitleASC = "albums.title ASC"
@@ -16,6 +16,7 @@ func MyTimeline(db *gorm.DB, user *models.User, paginate *models.Pagination, onl
 	query := db.
 		Joins("JOIN albums ON media.album_id = albums.id").
 		Where("albums.id IN (?)", db.Table("user_albums").Select("user_albums.album_id").Where("user_id = ?", user.ID))
+	query = models.OnlyStackCovers(db, query)
 
 	switch drivers.GetDatabaseDriverType(db) {
 	case drivers.POSTGRES:
@@ -51,6 +52,10 @@ func MyTimeline(db *gorm.DB, user *models.User, paginate *models.Pagination, onl
 				Where("user_media_data.favorite"))
 	}
 
+	if minRating != nil {
+		query = models.FilterMinRating(db, query, user.ID, *minRating)
+	}
+
 	query = models.FormatSQL(query, nil, paginate)
 
 	var media []*models.Media
@@ -1,13 +1,12 @@
 package actions
 
 import (
+	"context"
 	"errors"
-	"os"
-	"path"
 	"strconv"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
-	"github.com/kkovaletp/photoview/api/utils"
+	"github.com/kkovaletp/photoview/api/storage"
 	"gorm.io/gorm"
 )
 
@@ -61,8 +60,7 @@ func DeleteUser(db *gorm.DB, userID int) (*models.User, error) {
 func cleanup(deletedAlbumIDs []int) error {
 	var err error
 	for _, deletedAlbumID := range deletedAlbumIDs {
-		cachePath := path.Join(utils.MediaCachePath(), strconv.Itoa(int(deletedAlbumID)))
-		if err = os.RemoveAll(cachePath); err != nil {
+		if err = storage.Default().DeletePrefix(context.Background(), strconv.Itoa(int(deletedAlbumID))); err != nil {
 			return err
 		}
 	}
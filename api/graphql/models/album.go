@@ -3,8 +3,10 @@ package models
 import (
 	"crypto/md5"
 	"encoding/hex"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Album struct {
@@ -18,6 +20,47 @@ type Album struct {
 	Path     string `gorm:"not null"`
 	PathHash string `gorm:"unique"`
 	CoverID  *int
+	// ScanArchives makes the scanner show the ZIP archives below this root album as albums
+	ScanArchives bool `gorm:"not null;default:false"`
+	// ArchiveModTime is the modification time of the archive of this album when it was last scanned completely
+	ArchiveModTime *time.Time
+	// CustomTitle is given by a user and shown instead of Title, which the scanner derives from the directory name
+	CustomTitle *string
+	// Description is a markdown text given by a user
+	Description *string `gorm:"type:text"`
+}
+
+// MaxAlbumTitleLength is the maximum number of characters of the custom title of an album
+const MaxAlbumTitleLength = 255
+
+// DisplayTitle returns the custom title of the album if it has one, otherwise its title from the filesystem.
+func (a *Album) DisplayTitle() string {
+	if a.CustomTitle != nil {
+		return *a.CustomTitle
+	}
+	return a.Title
+}
+
+// TitleOrderBy is the `order_by` value of Ordering which sorts albums by their displayed title, see FormatAlbumSQL.
+const TitleOrderBy = "title"
+
+// FormatAlbumSQL is FormatSQL for album queries, which sorts albums by their custom title when ordered by title.
+func FormatAlbumSQL(tx *gorm.DB, order *Ordering, paginate *Pagination) *gorm.DB {
+	if order == nil || order.OrderBy == nil || *order.OrderBy != TitleOrderBy {
+		return FormatSQL(tx, order, paginate)
+	}
+
+	direction := "ASC"
+	if order.OrderDirection != nil && *order.OrderDirection == OrderDirectionDesc {
+		direction = "DESC"
+	}
+
+	tx = tx.Order(clause.OrderBy{Expression: clause.Expr{
+		SQL:                "COALESCE(albums.custom_title, albums.title) " + direction + ", albums.id " + direction,
+		WithoutParentheses: true,
+	}})
+
+	return FormatSQL(tx, nil, paginate)
 }
 
 func (a *Album) FilePath() string {
@@ -31,6 +31,19 @@ type MediaDownload struct {
 	MediaURL *MediaURL `json:"mediaUrl"`
 }
 
+// The edits to apply to a photo, omitted fields leave the image as is
+type MediaEditInput struct {
+	Rotation       int     `json:"rotation"`
+	FlipHorizontal bool    `json:"flipHorizontal"`
+	FlipVertical   bool    `json:"flipVertical"`
+	CropX          float64 `json:"cropX"`
+	CropY          float64 `json:"cropY"`
+	CropWidth      float64 `json:"cropWidth"`
+	CropHeight     float64 `json:"cropHeight"`
+	Exposure       float64 `json:"exposure"`
+	Contrast       float64 `json:"contrast"`
+}
+
 type Mutation struct {
 }
 
@@ -54,7 +67,7 @@ type Notification struct {
 
 // Used to specify how to sort items
 type Ordering struct {
-	// A column in the database to order by
+	// A column in the database to order by, or `rating` to order media by the rating of the logged in user
 	OrderBy        *string         `json:"order_by,omitempty"`
 	OrderDirection *OrderDirection `json:"order_direction,omitempty"`
 }
@@ -67,6 +80,13 @@ type Pagination struct {
 	Offset *int `json:"offset,omitempty"`
 }
 
+// Limits media to those shot at a place, a missing region or city matches any
+type PlaceFilter struct {
+	CountryCode string  `json:"countryCode"`
+	Region      *string `json:"region,omitempty"`
+	City        *string `json:"city,omitempty"`
+}
+
 type Query struct {
 }
 
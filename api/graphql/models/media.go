@@ -3,10 +3,10 @@ package models
 import (
 	"fmt"
 	"path"
-	"strconv"
 	"strings"
 	"time"
 
+	"github.com/kkovaletp/photoview/api/storage"
 	"github.com/kkovaletp/photoview/api/utils"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -30,6 +30,24 @@ type Media struct {
 	SideCarHash     *string      `gorm:"unique"`
 	Faces           []*ImageFace `gorm:"constraint:OnDelete:CASCADE;"`
 	Blurhash        *string      `gorm:""`
+	StackID         *int         `gorm:"index"`
+	// StackExcluded is set when a user takes the media out of a stack, so the scanner doesn't stack it again
+	StackExcluded bool `gorm:"not null;default:false"`
+	// Caption is given by a user and shown instead of the description read from the file
+	Caption *string `gorm:"type:text"`
+	// OriginalDateShot is the date read from the file while DateShot is corrected by a user, nil otherwise
+	OriginalDateShot *time.Time
+	// DateSource is where the date read from the file was found, nil for media scanned before it was recorded
+	DateSource *MediaDateSource
+	// FileSize and FileModTime are the size and modification time of the file when its metadata was last read,
+	// nil for media scanned before they were recorded
+	FileSize    *int64
+	FileModTime *time.Time
+	// FileChanged is set by the scanner if the file changed since its metadata was last read. It is not stored.
+	FileChanged bool `gorm:"-"`
+	// LocalPath is a temporary copy of the file of the media to process, if Path can't be read directly,
+	// as for media inside archives
+	LocalPath string `gorm:"-"`
 }
 
 func (Media) TableName() string {
@@ -43,10 +61,68 @@ func (m *Media) BeforeSave(tx *gorm.DB) error {
 	return nil
 }
 
+// FilePath returns the path to read the file of the media from.
+func (m *Media) FilePath() string {
+	if m.LocalPath != "" {
+		return m.LocalPath
+	}
+	return m.Path
+}
+
 func (m *Media) Date() time.Time {
 	return m.DateShot
 }
 
+// FileDateShot returns the date read from the file of the media, ignoring corrections by users.
+func (m *Media) FileDateShot() time.Time {
+	if m.OriginalDateShot != nil {
+		return *m.OriginalDateShot
+	}
+	return m.DateShot
+}
+
+// SetFileDateShot updates the date read from the file of the media.
+// DateShot is left as is while it is corrected by a user, so a rescan doesn't undo the correction.
+func (m *Media) SetFileDateShot(date time.Time) {
+	if m.OriginalDateShot != nil {
+		m.OriginalDateShot = &date
+		return
+	}
+	m.DateShot = date
+}
+
+// SetFileInfo records the size and modification time of the file of the media, and returns whether they changed.
+// Media scanned before they were recorded don't count as changed. The modification time is compared in seconds,
+// as databases store it with different precisions.
+func (m *Media) SetFileInfo(size int64, modTime time.Time) bool {
+	modTime = modTime.Truncate(time.Second).UTC()
+	changed := m.FileSize != nil && m.FileModTime != nil &&
+		(*m.FileSize != size || m.FileModTime.Unix() != modTime.Unix())
+
+	m.FileSize = &size
+	m.FileModTime = &modTime
+
+	return changed
+}
+
+// CorrectDateShot sets DateShot to a date given by a user, keeping the date read from the file.
+func (m *Media) CorrectDateShot(date time.Time) {
+	if m.OriginalDateShot == nil {
+		original := m.DateShot
+		m.OriginalDateShot = &original
+	}
+	m.DateShot = date
+}
+
+// ResetDateShot undoes the corrections of DateShot, going back to the date read from the file.
+func (m *Media) ResetDateShot() {
+	if m.OriginalDateShot == nil {
+		return
+	}
+	m.DateShot = *m.OriginalDateShot
+	m.OriginalDateShot = nil
+}
+
 func (m *Media) GetThumbnail() (*MediaURL, error) {
 	if len(m.MediaURL) == 0 {
 		return nil, errors.New("media.MediaURL is empty")
@@ -77,8 +153,9 @@ func (m *Media) GetHighRes() (*MediaURL, error) {
 	return nil, nil
 }
 
+// CachePath returns the local directory the files of the media cache are generated in, see storage.WorkDir.
 func (m *Media) CachePath() (string, error) {
-	return utils.CachePathForMedia(m.AlbumID, m.ID)
+	return storage.WorkDir(m.AlbumID, m.ID)
 }
 
 type MediaType string
@@ -93,6 +170,20 @@ var AllMediaType = []MediaType{
 	MediaTypeVideo,
 }
 
+// MediaDateSource is where the scanner found the date a media was shot
+type MediaDateSource string
+
+const (
+	// MediaDateSourceExif is the date from the EXIF or XMP metadata of the file
+	MediaDateSourceExif MediaDateSource = "Exif"
+	// MediaDateSourceFilename is the date in the file name, like `IMG_20200131_142233.jpg`
+	MediaDateSourceFilename MediaDateSource = "Filename"
+	// MediaDateSourceFolder is the date in the name of a folder holding the file, like `2019-07 Italy`
+	MediaDateSourceFolder MediaDateSource = "Folder"
+	// MediaDateSourceModTime is the modification time of the file
+	MediaDateSourceModTime MediaDateSource = "ModTime"
+)
+
 type MediaPurpose string
 
 const (
@@ -101,6 +192,10 @@ const (
 	MediaOriginal  MediaPurpose = "original"
 	VideoWeb       MediaPurpose = "video-web"
 	VideoThumbnail MediaPurpose = "video-thumbnail"
+	VideoSprite    MediaPurpose = "video-sprite"
+	VideoVTT       MediaPurpose = "video-vtt"
+	VideoPreview   MediaPurpose = "video-preview"
+	MotionVideo    MediaPurpose = "motion-video"
 )
 
 type MediaURL struct {
@@ -113,6 +208,8 @@ type MediaURL struct {
 	Purpose     MediaPurpose `gorm:"not null;index"`
 	ContentType string       `gorm:"not null"`
 	FileSize    int64        `gorm:"not null"`
+	// LastAccessedAt is when the cached file was last served, used to evict the least recently used files from the cache
+	LastAccessedAt *time.Time `gorm:"index"`
 }
 
 func (p *MediaURL) URL() string {
@@ -127,20 +224,40 @@ func (p *MediaURL) URL() string {
 	return imageURL.String()
 }
 
-func (p *MediaURL) CachedPath() (string, error) {
-	var cachedPath string
+// CacheKey returns the key of the file in the storage of the media cache.
+func (p *MediaURL) CacheKey() (string, error) {
+	if p.Media == nil {
+		return "", errors.New("mediaURL.Media is nil")
+	}
 
+	if p.Purpose == PhotoThumbnail || p.Purpose == PhotoHighRes || p.Purpose == VideoThumbnail || p.Purpose == VideoWeb ||
+		p.Purpose == VideoSprite || p.Purpose == VideoVTT || p.Purpose == VideoPreview ||
+		p.Purpose == MotionVideo {
+		return storage.MediaKey(p.Media.AlbumID, p.MediaID, p.MediaName), nil
+	}
+
+	return "", errors.New(fmt.Sprintf("cannot determine cache path for purpose (%s)", p.Purpose))
+}
+
+// CachedPath returns the path of the file on the local disk, which is the media itself for originals.
+// It fails for cached files if the media cache is not stored on the local disk.
+func (p *MediaURL) CachedPath() (string, error) {
 	if p.Media == nil {
 		return "", errors.New("mediaURL.Media is nil")
 	}
 
-	if p.Purpose == PhotoThumbnail || p.Purpose == PhotoHighRes || p.Purpose == VideoThumbnail || p.Purpose == VideoWeb {
-		cachedPath = path.Join(utils.MediaCachePath(), strconv.Itoa(int(p.Media.AlbumID)), strconv.Itoa(int(p.MediaID)),
-			p.MediaName)
-	} else if p.Purpose == MediaOriginal {
-		cachedPath = p.Media.Path
-	} else {
-		return "", errors.New(fmt.Sprintf("cannot determine cache path for purpose (%s)", p.Purpose))
+	if p.Purpose == MediaOriginal {
+		return p.Media.Path, nil
+	}
+
+	key, err := p.CacheKey()
+	if err != nil {
+		return "", err
+	}
+
+	cachedPath := storage.Default().LocalPath(key)
+	if cachedPath == "" {
+		return "", errors.New("media cache is not stored on the local disk")
 	}
 
 	return cachedPath, nil
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// MediaCacheStats is the disk usage of the media cache, reported to admins.
+type MediaCacheStats struct {
+	Size            int64
+	FileCount       int
+	MaxSize         *int64
+	EvictThumbnails bool
+	Purposes        []*MediaCachePurposeUsage
+	// EvictedFiles, EvictedSize and LastEviction count the evictions since the server started
+	EvictedFiles int
+	EvictedSize  int64
+	LastEviction *time.Time
+}
+
+// MediaCachePurposeUsage is the disk usage of the cached files of one purpose.
+type MediaCachePurposeUsage struct {
+	// Purpose is nil for files not referenced by any media
+	Purpose   *string
+	FileCount int
+	Size      int64
+}
+
+// MediaCacheCheckResult is the outcome of reconciling the media cache with the media URLs in the database.
+type MediaCacheCheckResult struct {
+	DeletedFiles  int
+	ReclaimedSize int64
+	MissingFiles  int
+	QueuedMedia   int
+}
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// MediaEdit holds the non-destructive edits of a photo. They are applied to the derivatives of the photo,
+// the original file is never modified.
+//
+// The edits are applied in order on the auto-oriented original: rotation, flips, crop and then the adjustments.
+type MediaEdit struct {
+	Model
+	MediaID int   `gorm:"not null;unique"`
+	Media   Media `gorm:"constraint:OnDelete:CASCADE;"`
+	// Rotation in degrees clockwise, one of 0, 90, 180 or 270
+	Rotation       int  `gorm:"not null;default:0"`
+	FlipHorizontal bool `gorm:"not null;default:false"`
+	FlipVertical   bool `gorm:"not null;default:false"`
+	// The crop rectangle, relative (0 to 1) to the rotated and flipped image
+	CropX      float64 `gorm:"not null;default:0"`
+	CropY      float64 `gorm:"not null;default:0"`
+	CropWidth  float64 `gorm:"not null;default:1"`
+	CropHeight float64 `gorm:"not null;default:1"`
+	// Exposure compensation in stops, between -3 and 3
+	Exposure float64 `gorm:"not null;default:0"`
+	// Contrast adjustment, between -100 and 100
+	Contrast float64 `gorm:"not null;default:0"`
+}
+
+func (MediaEdit) TableName() string {
+	return "media_edits"
+}
+
+// Validate checks that the values of the edit are in range.
+func (e *MediaEdit) Validate() error {
+	if e.Rotation%90 != 0 || e.Rotation < 0 || e.Rotation >= 360 {
+		return errors.New("rotation must be one of 0, 90, 180 or 270 degrees")
+	}
+
+	if e.CropX < 0 || e.CropY < 0 || e.CropWidth <= 0 || e.CropHeight <= 0 ||
+		e.CropX+e.CropWidth > 1 || e.CropY+e.CropHeight > 1 {
+		return errors.New("crop rectangle must lie within the image")
+	}
+
+	if math.Abs(e.Exposure) > 3 {
+		return errors.New("exposure must be between -3 and 3")
+	}
+
+	if math.Abs(e.Contrast) > 100 {
+		return errors.New("contrast must be between -100 and 100")
+	}
+
+	return nil
+}
+
+// transformPoint maps a relative point of the original image to the edited image.
+func (e *MediaEdit) transformPoint(x, y float64) (float64, float64) {
+	switch e.Rotation {
+	case 90:
+		x, y = 1-y, x
+	case 180:
+		x, y = 1-x, 1-y
+	case 270:
+		x, y = y, 1-x
+	}
+
+	if e.FlipHorizontal {
+		x = 1 - x
+	}
+	if e.FlipVertical {
+		y = 1 - y
+	}
+
+	return (x - e.CropX) / e.CropWidth, (y - e.CropY) / e.CropHeight
+}
+
+// inversePoint maps a relative point of the edited image back to the original image.
+func (e *MediaEdit) inversePoint(x, y float64) (float64, float64) {
+	x = x*e.CropWidth + e.CropX
+	y = y*e.CropHeight + e.CropY
+
+	if e.FlipHorizontal {
+		x = 1 - x
+	}
+	if e.FlipVertical {
+		y = 1 - y
+	}
+
+	switch e.Rotation {
+	case 90:
+		x, y = y, 1-x
+	case 180:
+		x, y = 1-x, 1-y
+	case 270:
+		x, y = 1-y, x
+	}
+
+	return x, y
+}
+
+// TransformFaceRectangle moves a face rectangle, relative to the image edited with `from`, to where it lies
+// in the image edited with `to`. A nil edit stands for the original image.
+//
+// The result is not clamped to the image, so a face cropped out of the image is back in place once the crop is undone.
+func TransformFaceRectangle(rect FaceRectangle, from *MediaEdit, to *MediaEdit) FaceRectangle {
+	x1, y1 := rect.MinX, rect.MinY
+	x2, y2 := rect.MaxX, rect.MaxY
+
+	if from != nil {
+		x1, y1 = from.inversePoint(x1, y1)
+		x2, y2 = from.inversePoint(x2, y2)
+	}
+
+	if to != nil {
+		x1, y1 = to.transformPoint(x1, y1)
+		x2, y2 = to.transformPoint(x2, y2)
+	}
+
+	return FaceRectangle{
+		MinX: math.Min(x1, x2),
+		MaxX: math.Max(x1, x2),
+		MinY: math.Min(y1, y2),
+		MaxY: math.Max(y1, y2),
+	}
+}
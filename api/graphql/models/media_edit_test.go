@@ -0,0 +1,71 @@
+package models_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+)
+
+func rectanglesAlmostEqual(a, b models.FaceRectangle) bool {
+	const epsilon = 1e-9
+	return math.Abs(a.MinX-b.MinX) < epsilon && math.Abs(a.MaxX-b.MaxX) < epsilon &&
+		math.Abs(a.MinY-b.MinY) < epsilon && math.Abs(a.MaxY-b.MaxY) < epsilon
+}
+
+func TestTransformFaceRectangle(t *testing.T) {
+	rect := models.FaceRectangle{MinX: 0.1, MaxX: 0.3, MinY: 0.2, MaxY: 0.5}
+	fullCrop := models.MediaEdit{CropWidth: 1, CropHeight: 1}
+
+	rotate := func(degrees int) *models.MediaEdit {
+		edit := fullCrop
+		edit.Rotation = degrees
+		return &edit
+	}
+
+	flipHorizontal := fullCrop
+	flipHorizontal.FlipHorizontal = true
+
+	crop := models.MediaEdit{CropX: 0.1, CropY: 0.2, CropWidth: 0.5, CropHeight: 0.5}
+
+	tests := []struct {
+		name string
+		from *models.MediaEdit
+		to   *models.MediaEdit
+		want models.FaceRectangle
+	}{
+		{"Identity", nil, nil, rect},
+		{"Rotate90", nil, rotate(90), models.FaceRectangle{MinX: 0.5, MaxX: 0.8, MinY: 0.1, MaxY: 0.3}},
+		{"Rotate180", nil, rotate(180), models.FaceRectangle{MinX: 0.7, MaxX: 0.9, MinY: 0.5, MaxY: 0.8}},
+		{"Rotate270", nil, rotate(270), models.FaceRectangle{MinX: 0.2, MaxX: 0.5, MinY: 0.7, MaxY: 0.9}},
+		{"FlipHorizontal", nil, &flipHorizontal, models.FaceRectangle{MinX: 0.7, MaxX: 0.9, MinY: 0.2, MaxY: 0.5}},
+		{"Crop", nil, &crop, models.FaceRectangle{MinX: 0, MaxX: 0.4, MinY: 0, MaxY: 0.6}},
+		{"Revert", rotate(90), nil, models.FaceRectangle{MinX: 0.2, MaxX: 0.5, MinY: 0.7, MaxY: 0.9}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := models.TransformFaceRectangle(rect, tc.from, tc.to)
+			if !rectanglesAlmostEqual(got, tc.want) {
+				t.Errorf("TransformFaceRectangle() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTransformFaceRectangleRoundTrip(t *testing.T) {
+	rect := models.FaceRectangle{MinX: 0.1, MaxX: 0.3, MinY: 0.2, MaxY: 0.5}
+	edit := &models.MediaEdit{
+		Rotation:     270,
+		FlipVertical: true,
+		CropX:        0.25,
+		CropY:        0.1,
+		CropWidth:    0.5,
+		CropHeight:   0.6,
+	}
+
+	edited := models.TransformFaceRectangle(rect, nil, edit)
+	if got := models.TransformFaceRectangle(edited, edit, nil); !rectanglesAlmostEqual(got, rect) {
+		t.Errorf("reverting %+v gave %+v, want %+v", edited, got, rect)
+	}
+}
@@ -22,6 +22,41 @@ type MediaEXIF struct {
 	ExposureProgram *int64
 	GPSLatitude     *float64
 	GPSLongitude    *float64
+	BurstID         *string `gorm:"index"`
+
+	WhiteBalance     *int64
+	MeteringMode     *int64
+	ColorSpace       *int64
+	LensMaker        *string
+	LensSerialNumber *string
+	Software         *string
+	Artist           *string
+	Copyright        *string
+	ImageWidth       *int
+	ImageHeight      *int
+	// GPSAltitude is in meters above the sea level and GPSDirection in degrees clockwise from north
+	GPSAltitude  *float64
+	GPSDirection *float64
+
+	// Panorama metadata from the GPano XMP namespace
+	ProjectionType         *string
+	FullPanoWidth          *int
+	FullPanoHeight         *int
+	CroppedAreaImageWidth  *int
+	CroppedAreaImageHeight *int
+	CroppedAreaLeft        *int
+	CroppedAreaTop         *int
+	PoseHeading            *float64
+
+	// DateShotIsModTime is set by the parser if DateShot is the modification time of the file,
+	// as the metadata has no date. It is not stored.
+	DateShotIsModTime bool `gorm:"-"`
+
+	// GPSOverridden is set while the coordinates are set or cleared by a user,
+	// the coordinates read from the file are kept in OriginalGPSLatitude and OriginalGPSLongitude meanwhile
+	GPSOverridden        bool `gorm:"not null;default:false"`
+	OriginalGPSLatitude  *float64
+	OriginalGPSLongitude *float64
 }
 
 func (MediaEXIF) TableName() string {
@@ -43,6 +78,64 @@ func (exif *MediaEXIF) Coordinates() *Coordinates {
 	}
 }
 
+// OverrideGPS sets the coordinates to ones given by a user, or clears them if nil,
+// keeping the coordinates read from the file.
+func (exif *MediaEXIF) OverrideGPS(latitude, longitude *float64) {
+	if !exif.GPSOverridden {
+		exif.OriginalGPSLatitude = exif.GPSLatitude
+		exif.OriginalGPSLongitude = exif.GPSLongitude
+		exif.GPSOverridden = true
+	}
+
+	exif.GPSLatitude = latitude
+	exif.GPSLongitude = longitude
+}
+
+// ResetGPS undoes the override of the coordinates, going back to the coordinates read from the file.
+func (exif *MediaEXIF) ResetGPS() {
+	if !exif.GPSOverridden {
+		return
+	}
+
+	exif.GPSLatitude = exif.OriginalGPSLatitude
+	exif.GPSLongitude = exif.OriginalGPSLongitude
+	exif.OriginalGPSLatitude = nil
+	exif.OriginalGPSLongitude = nil
+	exif.GPSOverridden = false
+}
+
+// KeepGPSOverride keeps the coordinates set or cleared by a user in the `saved` metadata,
+// when the metadata is read again from the file. The coordinates read now become the original ones.
+func (exif *MediaEXIF) KeepGPSOverride(saved *MediaEXIF) {
+	if !saved.GPSOverridden {
+		return
+	}
+
+	exif.OriginalGPSLatitude = exif.GPSLatitude
+	exif.OriginalGPSLongitude = exif.GPSLongitude
+	exif.GPSLatitude = saved.GPSLatitude
+	exif.GPSLongitude = saved.GPSLongitude
+	exif.GPSOverridden = true
+}
+
+// Panorama returns the panorama metadata of the media, or nil if it is no panorama.
+func (exif *MediaEXIF) Panorama() *Panorama {
+	if exif.ProjectionType == nil {
+		return nil
+	}
+
+	return &Panorama{
+		ProjectionType:         *exif.ProjectionType,
+		FullPanoWidth:          exif.FullPanoWidth,
+		FullPanoHeight:         exif.FullPanoHeight,
+		CroppedAreaImageWidth:  exif.CroppedAreaImageWidth,
+		CroppedAreaImageHeight: exif.CroppedAreaImageHeight,
+		CroppedAreaLeft:        exif.CroppedAreaLeft,
+		CroppedAreaTop:         exif.CroppedAreaTop,
+		PoseHeading:            exif.PoseHeading,
+	}
+}
+
 const rfc3339WithoutTimezone = "2006-01-02T15:04:05.999"
 
 func (exif *MediaEXIF) DateShotWithOffset() *string {
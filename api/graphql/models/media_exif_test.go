@@ -6,6 +6,7 @@ import (
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
 )
 
 // Different database has different behavior when storing date with timezone.
@@ -61,3 +62,29 @@ func TestDatabaseReproduceDateWithOffset(t *testing.T) {
 		})
 	}
 }
+
+func TestMediaEXIFKeepGPSOverride(t *testing.T) {
+	fileLatitude, fileLongitude := 41.9, 12.5
+	userLatitude, userLongitude := 45.4, 12.3
+
+	saved := models.MediaEXIF{GPSLatitude: &fileLatitude, GPSLongitude: &fileLongitude}
+	saved.OverrideGPS(&userLatitude, &userLongitude)
+
+	newLatitude, newLongitude := 43.7, 11.2
+	parsed := models.MediaEXIF{GPSLatitude: &newLatitude, GPSLongitude: &newLongitude}
+	parsed.KeepGPSOverride(&saved)
+
+	assert.True(t, parsed.GPSOverridden)
+	assert.Equal(t, userLatitude, *parsed.GPSLatitude)
+	assert.Equal(t, userLongitude, *parsed.GPSLongitude)
+	assert.Equal(t, newLatitude, *parsed.OriginalGPSLatitude, "the coordinates read again become the original ones")
+
+	parsed.ResetGPS()
+	assert.Equal(t, newLatitude, *parsed.GPSLatitude)
+	assert.Equal(t, newLongitude, *parsed.GPSLongitude)
+
+	notOverridden := models.MediaEXIF{GPSLatitude: &newLatitude, GPSLongitude: &newLongitude}
+	notOverridden.KeepGPSOverride(&models.MediaEXIF{GPSLatitude: &fileLatitude, GPSLongitude: &fileLongitude})
+	assert.False(t, notOverridden.GPSOverridden)
+	assert.Equal(t, newLatitude, *notOverridden.GPSLatitude)
+}
@@ -0,0 +1,53 @@
+package models
+
+// MediaPlace is the place a geotagged media was shot at, resolved offline from its GPS coordinates
+// by the scanner. Fields of places which couldn't be resolved, like on the open sea, are nil.
+type MediaPlace struct {
+	Model
+	MediaID int   `gorm:"not null;unique"`
+	Media   Media `gorm:"constraint:OnDelete:CASCADE;"`
+	// Latitude and Longitude are the coordinates the place was resolved from, to resolve it again when they change
+	Latitude  float64 `gorm:"not null"`
+	Longitude float64 `gorm:"not null"`
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country, like `IT`
+	CountryCode *string `gorm:"size:2;index"`
+	Country     *string
+	Region      *string
+	City        *string
+}
+
+func (MediaPlace) TableName() string {
+	return "media_places"
+}
+
+// PlaceLevel is the level of a place in the hierarchy of places
+type PlaceLevel string
+
+const (
+	PlaceLevelCountry PlaceLevel = "Country"
+	PlaceLevelRegion  PlaceLevel = "Region"
+	PlaceLevelCity    PlaceLevel = "City"
+)
+
+// Place is a country, region or city where media of a user were shot
+type Place struct {
+	Level       PlaceLevel
+	Name        string
+	CountryCode string
+	Country     string
+	Region      *string
+	City        *string
+	MediaCount  int
+	// CoverID is the media shown for the place, the most recently added one
+	CoverID  int
+	Children []*Place
+}
+
+// Filter returns the filter matching the media shot at the place
+func (p *Place) Filter() *PlaceFilter {
+	return &PlaceFilter{
+		CountryCode: p.CountryCode,
+		Region:      p.Region,
+		City:        p.City,
+	}
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MediaStack groups near-identical media of an album, like the photos of a burst, so they are listed as a single entry.
+type MediaStack struct {
+	Model
+	AlbumID int   `gorm:"not null;index"`
+	Album   Album `gorm:"constraint:OnDelete:CASCADE;"`
+	// CoverID is the media representing the stack in listings, it is always one of the members
+	CoverID int `gorm:"not null;index"`
+	// Manual stacks were made by a user, the scanner leaves their members alone
+	Manual  bool     `gorm:"not null;default:false"`
+	Members []*Media `gorm:"foreignKey:StackID"`
+}
+
+func (MediaStack) TableName() string {
+	return "media_stacks"
+}
+
+// OnlyStackCovers limits a media query to a single entry per stack, its cover. Media outside of stacks are kept.
+func OnlyStackCovers(db *gorm.DB, query *gorm.DB) *gorm.DB {
+	return query.Where("media.stack_id IS NULL OR media.id IN (?)", db.Model(&MediaStack{}).Select("media_stacks.cover_id"))
+}
+
+// RepairMediaStacks brings the stacks `stackIDs` back in a consistent state after members have been removed.
+// Stacks left with less than two members are dissolved, and stacks which lost their cover get a new one.
+func RepairMediaStacks(tx *gorm.DB, stackIDs []int) error {
+	for _, stackID := range stackIDs {
+		var memberIDs []int
+		if err := tx.Model(&Media{}).Where("stack_id = ?", stackID).Order("id").Pluck("id", &memberIDs).Error; err != nil {
+			return errors.Wrap(err, "get media stack members")
+		}
+
+		if len(memberIDs) < 2 {
+			if err := tx.Model(&Media{}).Where("stack_id = ?", stackID).Update("stack_id", nil).Error; err != nil {
+				return errors.Wrap(err, "remove last member of media stack")
+			}
+
+			if err := tx.Delete(&MediaStack{}, stackID).Error; err != nil {
+				return errors.Wrap(err, "delete media stack")
+			}
+
+			continue
+		}
+
+		err := tx.Model(&MediaStack{}).
+			Where("id = ? AND cover_id NOT IN (?)", stackID, memberIDs).
+			Update("cover_id", memberIDs[0]).Error
+		if err != nil {
+			return errors.Wrap(err, "update cover of media stack")
+		}
+	}
+
+	return nil
+}
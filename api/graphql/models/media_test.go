@@ -3,10 +3,13 @@ package models_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/test_utils"
 	"github.com/kkovaletp/photoview/api/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const mimeJpeg = "image/jpeg"
@@ -125,3 +128,23 @@ func TestMediaGetThumbnail(t *testing.T) {
 	assert.Equal(t, thumb.MediaName, "video-thumbnail.jpg")
 	assert.NotNil(t, thumb.Media)
 }
+
+func TestMediaSetFileInfo(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	album := models.Album{Title: "album", Path: "/photos/album"}
+	require.NoError(t, db.Save(&album).Error)
+
+	modTime := time.Date(2024, 6, 1, 10, 0, 0, 123456789, time.FixedZone("UTC+2", 2*60*60))
+
+	media := models.Media{Title: "photo.jpg", Path: "/photos/album/photo.jpg", AlbumID: album.ID}
+	assert.False(t, media.SetFileInfo(1000, modTime), "media scanned before the file info was recorded")
+	require.NoError(t, db.Save(&media).Error)
+
+	var saved models.Media
+	require.NoError(t, db.First(&saved, media.ID).Error)
+	assert.False(t, saved.SetFileInfo(1000, modTime), "unchanged after storing it in the database")
+	assert.True(t, saved.SetFileInfo(1001, modTime), "changed size")
+	assert.True(t, saved.SetFileInfo(1001, modTime.Add(time.Second)), "changed modification time")
+	assert.Equal(t, int64(1001), *saved.FileSize)
+}
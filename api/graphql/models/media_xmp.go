@@ -0,0 +1,37 @@
+package models
+
+// MediaXMP holds the descriptive metadata of a media, like ratings and keywords given in photo managers.
+// It is read from the XMP and IPTC metadata embedded in the media, overridden by its XMP sidecar file if there is one.
+type MediaXMP struct {
+	Model
+	MediaID int   `gorm:"not null;unique"`
+	Media   Media `gorm:"constraint:OnDelete:CASCADE;"`
+	// Rating is between 0 and 5 stars, or -1 if the media was rejected
+	Rating *int
+	// Label is the color label, like `Red`
+	Label       *string
+	Title       *string
+	Description *string
+	Keywords    []string `gorm:"type:text;serializer:json"`
+	// HierarchicalSubjects are keywords with levels separated by `|`, like `Places|Italy|Rome`
+	HierarchicalSubjects []string `gorm:"type:text;serializer:json"`
+	Creator              *string
+	Copyright            *string
+	// SidecarPath is the XMP sidecar file the metadata was read from, if any
+	SidecarPath *string
+	// SidecarHash is the MD5 hash of the sidecar file when it was read, to read it again when it changes
+	SidecarHash *string
+	// WriteBackHash is the MD5 hash of the sidecar file after Photoview last wrote to it, see scanner_tasks.WriteBackXMP.
+	// The scanner doesn't treat the sidecar as changed while it still has this hash.
+	WriteBackHash *string
+}
+
+func (MediaXMP) TableName() string {
+	return "media_xmp"
+}
+
+// IsEmpty returns true if no descriptive metadata was found.
+func (x *MediaXMP) IsEmpty() bool {
+	return x.Rating == nil && x.Label == nil && x.Title == nil && x.Description == nil &&
+		len(x.Keywords) == 0 && len(x.HierarchicalSubjects) == 0 && x.Creator == nil && x.Copyright == nil
+}
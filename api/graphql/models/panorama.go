@@ -0,0 +1,13 @@
+package models
+
+// Panorama describes how a panorama or 360° photo is projected onto the image, following the GPano XMP metadata.
+type Panorama struct {
+	ProjectionType         string
+	FullPanoWidth          *int
+	FullPanoHeight         *int
+	CroppedAreaImageWidth  *int
+	CroppedAreaImageHeight *int
+	CroppedAreaLeft        *int
+	CroppedAreaTop         *int
+	PoseHeading            *float64
+}
@@ -0,0 +1,166 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TagSeparator separates the levels of the path of a tag
+const TagSeparator = "/"
+
+// Tag is a keyword a user gives media. Tags are hierarchical, like `Places/Italy/Rome`, with a tag for every level.
+type Tag struct {
+	Model
+	UserID int  `gorm:"not null;uniqueIndex:idx_tags_user_path"`
+	User   User `gorm:"constraint:OnDelete:CASCADE;"`
+	// Name is the last level of the tag, like `Rome`
+	Name string `gorm:"not null"`
+	// Path is the full name of the tag, like `Places/Italy/Rome`
+	Path     string `gorm:"not null;size:512;uniqueIndex:idx_tags_user_path"`
+	ParentID *int   `gorm:"index"`
+	Parent   *Tag   `gorm:"constraint:OnDelete:CASCADE;"`
+	// MediaCount is the number of media owned by the user which are tagged with the tag or one below it,
+	// it is only set by FillTagMediaCounts
+	MediaCount *int `gorm:"-"`
+}
+
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// MediaTag links a tag to a media it was given to
+type MediaTag struct {
+	TagID   int   `gorm:"primaryKey;autoIncrement:false"`
+	Tag     Tag   `gorm:"constraint:OnDelete:CASCADE;"`
+	MediaID int   `gorm:"primaryKey;autoIncrement:false;index"`
+	Media   Media `gorm:"constraint:OnDelete:CASCADE;"`
+}
+
+func (MediaTag) TableName() string {
+	return "media_tags"
+}
+
+// TagPath joins the levels of a tag to its path. Separators inside a level are replaced, so it stays a single level.
+func TagPath(levels []string) string {
+	cleaned := make([]string, 0, len(levels))
+	for _, level := range levels {
+		level = strings.TrimSpace(strings.ReplaceAll(level, TagSeparator, "-"))
+		if level != "" {
+			cleaned = append(cleaned, level)
+		}
+	}
+
+	return strings.Join(cleaned, TagSeparator)
+}
+
+// NormalizeTagPath trims the levels of the tag path `path` and removes empty levels,
+// like `Places / Italy/` to `Places/Italy`. An error is returned if no level is left,
+// or if the path contains control characters like line breaks.
+func NormalizeTagPath(path string) (string, error) {
+	if strings.IndexFunc(path, unicode.IsControl) >= 0 {
+		return "", errors.New("tag must not contain control characters")
+	}
+
+	normalized := TagPath(strings.Split(path, TagSeparator))
+	if normalized == "" {
+		return "", errors.New("tag must not be empty")
+	}
+
+	return normalized, nil
+}
+
+// IsBelow reports whether the tag is `ancestorPath` itself or one of the tags below it.
+func (t *Tag) IsBelow(ancestorPath string) bool {
+	return t.Path == ancestorPath || strings.HasPrefix(t.Path, ancestorPath+TagSeparator)
+}
+
+// FindOrCreateTag returns the tag of the user at the normalized `path`, creating it and its missing parents.
+func FindOrCreateTag(tx *gorm.DB, userID int, path string) (*Tag, error) {
+	var parent *Tag
+	levels := strings.Split(path, TagSeparator)
+
+	for i, name := range levels {
+		tag := Tag{
+			UserID: userID,
+			Name:   name,
+			Path:   strings.Join(levels[:i+1], TagSeparator),
+		}
+		if parent != nil {
+			tag.ParentID = &parent.ID
+		}
+
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&tag).Error; err != nil {
+			return nil, errors.Wrapf(err, "create tag %s", tag.Path)
+		}
+
+		if err := tx.Where("user_id = ? AND path = ?", userID, tag.Path).First(&tag).Error; err != nil {
+			return nil, errors.Wrapf(err, "get tag %s", tag.Path)
+		}
+
+		parent = &tag
+	}
+
+	return parent, nil
+}
+
+// FillTagMediaCounts sets the MediaCount of the tags of the user `userID`.
+func FillTagMediaCounts(db *gorm.DB, userID int, tags []*Tag) error {
+	var userTags []*Tag
+	if err := db.Where("user_id = ?", userID).Find(&userTags).Error; err != nil {
+		return errors.Wrap(err, "get tags of user")
+	}
+
+	var links []*MediaTag
+	if err := db.Joins("JOIN tags ON tags.id = media_tags.tag_id").
+		Joins("JOIN media ON media.id = media_tags.media_id").
+		Where("tags.user_id = ?", userID).
+		Where("media.album_id IN (?)", db.Table("user_albums").Select("user_albums.album_id").Where("user_albums.user_id = ?", userID)).
+		Select("media_tags.tag_id", "media_tags.media_id").
+		Find(&links).Error; err != nil {
+		return errors.Wrap(err, "get tagged media of user")
+	}
+
+	mediaByTag := make(map[int][]int, len(userTags))
+	for _, link := range links {
+		mediaByTag[link.TagID] = append(mediaByTag[link.TagID], link.MediaID)
+	}
+
+	for _, tag := range tags {
+		media := make(map[int]struct{})
+		for _, userTag := range userTags {
+			if !userTag.IsBelow(tag.Path) {
+				continue
+			}
+
+			for _, mediaID := range mediaByTag[userTag.ID] {
+				media[mediaID] = struct{}{}
+			}
+		}
+
+		count := len(media)
+		tag.MediaCount = &count
+	}
+
+	return nil
+}
+
+// TagIDsBelow returns the ids of the tags of the user `userID` at `path` and below it, which is empty if it doesn't exist.
+func TagIDsBelow(db *gorm.DB, userID int, path string) ([]int, error) {
+	var userTags []*Tag
+	if err := db.Where("user_id = ?", userID).Find(&userTags).Error; err != nil {
+		return nil, errors.Wrap(err, "get tags of user")
+	}
+
+	ids := make([]int, 0)
+	for _, tag := range userTags {
+		if tag.IsBelow(path) {
+			ids = append(ids, tag.ID)
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,35 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTagPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"Places", "Places"},
+		{" Places / Italy/ ", "Places/Italy"},
+		{"Places//Italy/Rome/", "Places/Italy/Rome"},
+	}
+
+	for _, test := range tests {
+		normalized, err := models.NormalizeTagPath(test.path)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, normalized)
+	}
+
+	for _, path := range []string{"", " / ", "Places\nItaly", "Places\rItaly", "Places\tItaly", "Places\x00"} {
+		_, err := models.NormalizeTagPath(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestTagPath(t *testing.T) {
+	assert.Equal(t, "Music/AC-DC", models.TagPath([]string{"Music", "AC/DC"}))
+	assert.Equal(t, "Places/Italy", models.TagPath([]string{" Places", "", "Italy "}))
+}
@@ -25,8 +25,17 @@ type UserMediaData struct {
 	UserID   int  `gorm:"primaryKey;autoIncrement:false"`
 	MediaID  int  `gorm:"primaryKey;autoIncrement:false"`
 	Favorite bool `gorm:"not null;default:false"`
+	// Rating is between 1 and 5 stars, or 0 if the media isn't rated
+	Rating   int  `gorm:"not null;default:0"`
+	Rejected bool `gorm:"not null;default:false"`
 }
 
+// Bounds of the star rating of UserMediaData
+const (
+	MinRating = 0
+	MaxRating = 5
+)
+
 type UserAlbums struct {
 	UserID  int `gorm:"primaryKey;autoIncrement:false;constraint:OnDelete:CASCADE;"`
 	AlbumID int `gorm:"primaryKey;autoIncrement:false;constraint:OnDelete:CASCADE;"`
@@ -187,7 +196,7 @@ func (user *User) FavoriteMedia(db *gorm.DB, mediaID int, favorite bool) (*Media
 		Favorite: favorite,
 	}
 
-	if err := db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&userMediaData).Error; err != nil {
+	if err := db.Clauses(upsertUserMediaData("favorite")).Create(&userMediaData).Error; err != nil {
 		return nil, errors.Wrapf(err, "update user favorite media in database")
 	}
 
@@ -198,3 +207,112 @@ func (user *User) FavoriteMedia(db *gorm.DB, mediaID int, favorite bool) (*Media
 
 	return &media, nil
 }
+
+// upsertUserMediaData inserts UserMediaData, or only updates `column` if the user already has data for the media.
+func upsertUserMediaData(column string) clause.OnConflict {
+	return clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "media_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{column, "updated_at"}),
+	}
+}
+
+// RateMedia sets the star rating of the user for all of `mediaIDs`, between 1 and 5 stars, or 0 to clear the rating
+func (user *User) RateMedia(db *gorm.DB, mediaIDs []int, rating int) ([]*Media, error) {
+	if rating < MinRating || rating > MaxRating {
+		return nil, errors.Errorf("rating must be between %d and %d", MinRating, MaxRating)
+	}
+
+	return user.updateMediaData(db, mediaIDs, "rating", func(data *UserMediaData) {
+		data.Rating = rating
+	})
+}
+
+// RejectMedia sets/clears the reject flag of the user for all of `mediaIDs`
+func (user *User) RejectMedia(db *gorm.DB, mediaIDs []int, rejected bool) ([]*Media, error) {
+	return user.updateMediaData(db, mediaIDs, "rejected", func(data *UserMediaData) {
+		data.Rejected = rejected
+	})
+}
+
+// updateMediaData sets `column` of the UserMediaData of all of `mediaIDs`, which must be owned by the user.
+func (user *User) updateMediaData(db *gorm.DB, mediaIDs []int, column string, set func(data *UserMediaData)) ([]*Media, error) {
+	var media []*Media
+	if err := db.Where("media.id IN (?)", mediaIDs).
+		Where("media.album_id IN (?)", db.Table("user_albums").Select("user_albums.album_id").Where("user_albums.user_id = ?", user.ID)).
+		Find(&media).Error; err != nil {
+		return nil, errors.Wrap(err, "get media from database")
+	}
+
+	found := make(map[int]bool, len(media))
+	for _, m := range media {
+		found[m.ID] = true
+	}
+
+	for _, id := range mediaIDs {
+		if !found[id] {
+			return nil, errors.Errorf("media %d not found", id)
+		}
+	}
+
+	if len(media) == 0 {
+		return media, nil
+	}
+
+	userMediaData := make([]*UserMediaData, len(media))
+	for i, m := range media {
+		userMediaData[i] = &UserMediaData{
+			UserID:  user.ID,
+			MediaID: m.ID,
+		}
+		set(userMediaData[i])
+	}
+
+	if err := db.Clauses(upsertUserMediaData(column)).Create(&userMediaData).Error; err != nil {
+		return nil, errors.Wrapf(err, "update user media %s in database", column)
+	}
+
+	return media, nil
+}
+
+// FilterMinRating limits a media query to media the user rated with at least `minRating` stars, leaving out rejected media.
+// With a `minRating` of 0, all media except rejected ones are kept.
+func FilterMinRating(db *gorm.DB, query *gorm.DB, userID int, minRating int) *gorm.DB {
+	if minRating <= MinRating {
+		return query.Where("NOT EXISTS (?)", db.Model(&UserMediaData{}).
+			Select("1").
+			Where("user_media_data.user_id = ? AND user_media_data.media_id = media.id", userID).
+			Where("user_media_data.rejected = ?", true))
+	}
+
+	return query.Where("EXISTS (?)", db.Model(&UserMediaData{}).
+		Select("1").
+		Where("user_media_data.user_id = ? AND user_media_data.media_id = media.id", userID).
+		Where("user_media_data.rating >= ?", minRating).
+		Where("user_media_data.rejected = ?", false))
+}
+
+// RatingOrderBy is the `order_by` value of Ordering which sorts media by the rating of the user, see FormatMediaSQL.
+const RatingOrderBy = "rating"
+
+// FormatMediaSQL is FormatSQL for media queries, which can also be ordered by the rating of the user `userID`.
+// Rejected media are sorted below media which aren't rated.
+func FormatMediaSQL(tx *gorm.DB, userID int, order *Ordering, paginate *Pagination) *gorm.DB {
+	if order == nil || order.OrderBy == nil || *order.OrderBy != RatingOrderBy {
+		return FormatSQL(tx, order, paginate)
+	}
+
+	direction := "ASC"
+	if order.OrderDirection != nil && *order.OrderDirection == OrderDirectionDesc {
+		direction = "DESC"
+	}
+
+	tx = tx.Order(clause.OrderBy{Expression: clause.Expr{
+		SQL: "COALESCE((SELECT CASE WHEN user_media_data.rejected THEN -1 ELSE user_media_data.rating END " +
+			"FROM user_media_data WHERE user_media_data.user_id = ? AND user_media_data.media_id = media.id), 0) " +
+			direction + ", media.id " + direction,
+		Vars:               []any{userID},
+		WithoutParentheses: true,
+	}})
+
+	return FormatSQL(tx, nil, paginate)
+}
@@ -10,6 +10,8 @@ type VideoMetadata struct {
 	Bitrate      *string
 	ColorProfile *string
 	Audio        *string
+	// PosterTime is the offset in seconds of the frame picked by the user as thumbnail
+	PosterTime *float64
 }
 
 func (metadata *VideoMetadata) Media() *Media {
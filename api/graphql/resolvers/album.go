@@ -18,7 +18,7 @@ import (
 )
 
 // Media is the resolver for the media field.
-func (r *albumResolver) Media(ctx context.Context, obj *models.Album, order *models.Ordering, paginate *models.Pagination, onlyFavorites *bool) ([]*models.Media, error) {
+func (r *albumResolver) Media(ctx context.Context, obj *models.Album, order *models.Ordering, paginate *models.Pagination, onlyFavorites *bool, minRating *int) ([]*models.Media, error) {
 	db := r.DB(ctx)
 
 	query := db.
@@ -26,6 +26,7 @@ func (r *albumResolver) Media(ctx context.Context, obj *models.Album, order *mod
 		Where("media.id IN (?)", db.Model(&models.MediaURL{}).
 			Select("media_urls.media_id").
 			Where("media_urls.media_id = media.id"))
+	query = models.OnlyStackCovers(db, query)
 
 	if onlyFavorites != nil && *onlyFavorites == true {
 		user := auth.UserFromContext(ctx)
@@ -40,7 +41,24 @@ func (r *albumResolver) Media(ctx context.Context, obj *models.Album, order *mod
 		query = query.Where("EXISTS (?)", favoriteQuery)
 	}
 
-	query = models.FormatSQL(query, order, paginate)
+	user := auth.UserFromContext(ctx)
+	if minRating != nil {
+		if user == nil {
+			return nil, errors.New("cannot filter media by rating without being authorized")
+		}
+
+		query = models.FilterMinRating(db, query, user.ID, *minRating)
+	}
+
+	if order != nil && order.OrderBy != nil && *order.OrderBy == models.RatingOrderBy {
+		if user == nil {
+			return nil, errors.New("cannot order media by rating without being authorized")
+		}
+
+		query = models.FormatMediaSQL(query, user.ID, order, paginate)
+	} else {
+		query = models.FormatSQL(query, order, paginate)
+	}
 
 	var media []*models.Media
 	if err := query.Find(&media).Error; err != nil {
@@ -55,7 +73,7 @@ func (r *albumResolver) SubAlbums(ctx context.Context, obj *models.Album, order
 	var albums []*models.Album
 
 	query := r.DB(ctx).Where("parent_album_id = ?", obj.ID)
-	query = models.FormatSQL(query, order, paginate)
+	query = models.FormatAlbumSQL(query, order, paginate)
 
 	if err := query.Find(&albums).Error; err != nil {
 		return nil, err
@@ -115,6 +133,16 @@ func (r *mutationResolver) SetAlbumCover(ctx context.Context, coverID int) (*mod
 	return actions.SetAlbumCover(r.DB(ctx), user, coverID)
 }
 
+// SetAlbumDetails is the resolver for the setAlbumDetails field.
+func (r *mutationResolver) SetAlbumDetails(ctx context.Context, albumID int, title *string, description *string) (*models.Album, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.SetAlbumDetails(r.DB(ctx), user, albumID, title, description)
+}
+
 // MyAlbums is the resolver for the myAlbums field.
 func (r *queryResolver) MyAlbums(ctx context.Context, order *models.Ordering, paginate *models.Pagination, onlyRoot *bool, showEmpty *bool, onlyWithFavorites *bool) ([]*models.Album, error) {
 	user := auth.UserFromContext(ctx)
@@ -8,13 +8,20 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/kkovaletp/photoview/api/dataloader"
 	api "github.com/kkovaletp/photoview/api/graphql"
 	"github.com/kkovaletp/photoview/api/graphql/auth"
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+	"github.com/kkovaletp/photoview/api/scanner"
+	"github.com/kkovaletp/photoview/api/scanner/externaltools/exif"
 	"github.com/kkovaletp/photoview/api/scanner/face_detection"
+	"github.com/kkovaletp/photoview/api/scanner/gpx"
+	"github.com/kkovaletp/photoview/api/scanner/media_archive"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_tasks"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -42,6 +49,51 @@ func (r *mediaResolver) VideoWeb(ctx context.Context, obj *models.Media) (*model
 	return dataloader.For(ctx).MediaVideoWeb.Load(obj.ID)
 }
 
+// VideoSprite is the resolver for the videoSprite field.
+func (r *mediaResolver) VideoSprite(ctx context.Context, obj *models.Media) (*models.MediaURL, error) {
+	if obj.Type != models.MediaTypeVideo {
+		return nil, nil
+	}
+
+	return dataloader.For(ctx).MediaVideoSprite.Load(obj.ID)
+}
+
+// VideoThumbnailTrack is the resolver for the videoThumbnailTrack field.
+func (r *mediaResolver) VideoThumbnailTrack(ctx context.Context, obj *models.Media) (*models.MediaURL, error) {
+	if obj.Type != models.MediaTypeVideo {
+		return nil, nil
+	}
+
+	return dataloader.For(ctx).MediaVideoVTT.Load(obj.ID)
+}
+
+// VideoPreview is the resolver for the videoPreview field.
+func (r *mediaResolver) VideoPreview(ctx context.Context, obj *models.Media) (*models.MediaURL, error) {
+	if obj.Type != models.MediaTypeVideo {
+		return nil, nil
+	}
+
+	return dataloader.For(ctx).MediaVideoPreview.Load(obj.ID)
+}
+
+// MotionVideo is the resolver for the motionVideo field.
+func (r *mediaResolver) MotionVideo(ctx context.Context, obj *models.Media) (*models.MediaURL, error) {
+	if obj.Type != models.MediaTypePhoto {
+		return nil, nil
+	}
+
+	return dataloader.For(ctx).MediaMotionVideo.Load(obj.ID)
+}
+
+// Caption is the resolver for the caption field.
+func (r *mediaResolver) Caption(ctx context.Context, obj *models.Media) (*string, error) {
+	if obj.Caption != nil {
+		return obj.Caption, nil
+	}
+
+	return dataloader.For(ctx).MediaCaption.Load(obj.ID)
+}
+
 // Album is the resolver for the album field.
 func (r *mediaResolver) Album(ctx context.Context, obj *models.Media) (*models.Album, error) {
 	var album models.Album
@@ -66,6 +118,20 @@ func (r *mediaResolver) Exif(ctx context.Context, obj *models.Media) (*models.Me
 	return &exif, nil
 }
 
+// Xmp is the resolver for the xmp field.
+func (r *mediaResolver) Xmp(ctx context.Context, obj *models.Media) (*models.MediaXMP, error) {
+	var xmp []*models.MediaXMP
+	if err := r.DB(ctx).Where("media_id = ?", obj.ID).Limit(1).Find(&xmp).Error; err != nil {
+		return nil, err
+	}
+
+	if len(xmp) == 0 {
+		return nil, nil
+	}
+
+	return xmp[0], nil
+}
+
 // Favorite is the resolver for the favorite field.
 func (r *mediaResolver) Favorite(ctx context.Context, obj *models.Media) (bool, error) {
 	user := auth.UserFromContext(ctx)
@@ -79,6 +145,36 @@ func (r *mediaResolver) Favorite(ctx context.Context, obj *models.Media) (bool,
 	})
 }
 
+// Rating is the resolver for the rating field.
+func (r *mediaResolver) Rating(ctx context.Context, obj *models.Media) (int, error) {
+	data, err := userMediaData(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+
+	return data.Rating, nil
+}
+
+// Rejected is the resolver for the rejected field.
+func (r *mediaResolver) Rejected(ctx context.Context, obj *models.Media) (bool, error) {
+	data, err := userMediaData(ctx, obj)
+	if err != nil {
+		return false, err
+	}
+
+	return data.Rejected, nil
+}
+
+// Tags is the resolver for the tags field.
+func (r *mediaResolver) Tags(ctx context.Context, obj *models.Media) ([]*models.Tag, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.MediaTags(r.DB(ctx), user, obj.ID)
+}
+
 // Type is the resolver for the type field.
 func (r *mediaResolver) Type(ctx context.Context, obj *models.Media) (models.MediaType, error) {
 	formattedType := models.MediaType(cases.Title(language.Und).String(string(obj.Type)))
@@ -118,6 +214,11 @@ func (r *mediaResolver) Downloads(ctx context.Context, obj *models.Media) ([]*mo
 			title = "Video thumbnail"
 		case url.Purpose == models.VideoWeb:
 			title = "Web optimized video"
+		case url.Purpose == models.MotionVideo:
+			title = "Motion video"
+		case url.Purpose == models.VideoSprite || url.Purpose == models.VideoVTT || url.Purpose == models.VideoPreview:
+			// Sprites, tracks and preview clips are only meant for the UI
+			continue
 		}
 
 		downloads = append(downloads, &models.MediaDownload{
@@ -147,6 +248,88 @@ func (r *mediaResolver) Faces(ctx context.Context, obj *models.Media) ([]*models
 	return faces, nil
 }
 
+// Stack is the resolver for the stack field.
+func (r *mediaResolver) Stack(ctx context.Context, obj *models.Media) (*models.MediaStack, error) {
+	if obj.StackID == nil {
+		return nil, nil
+	}
+
+	var stack models.MediaStack
+	if err := r.DB(ctx).First(&stack, *obj.StackID).Error; err != nil {
+		return nil, err
+	}
+
+	return &stack, nil
+}
+
+// Place is the resolver for the place field.
+func (r *mediaResolver) Place(ctx context.Context, obj *models.Media) (*models.MediaPlace, error) {
+	return dataloader.For(ctx).MediaPlace.Load(obj.ID)
+}
+
+// ProjectionType is the resolver for the projectionType field.
+func (r *mediaResolver) ProjectionType(ctx context.Context, obj *models.Media) (*string, error) {
+	panorama, err := r.Panorama(ctx, obj)
+	if err != nil || panorama == nil {
+		return nil, err
+	}
+
+	return &panorama.ProjectionType, nil
+}
+
+// Panorama is the resolver for the panorama field.
+func (r *mediaResolver) Panorama(ctx context.Context, obj *models.Media) (*models.Panorama, error) {
+	if obj.Type != models.MediaTypePhoto || obj.ExifID == nil {
+		return nil, nil
+	}
+
+	exif, err := r.Exif(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return exif.Panorama(), nil
+}
+
+// Edit is the resolver for the edit field.
+func (r *mediaResolver) Edit(ctx context.Context, obj *models.Media) (*models.MediaEdit, error) {
+	if obj.Type != models.MediaTypePhoto {
+		return nil, nil
+	}
+
+	var edits []*models.MediaEdit
+	if err := r.DB(ctx).Where("media_id = ?", obj.ID).Limit(1).Find(&edits).Error; err != nil {
+		return nil, err
+	}
+
+	if len(edits) == 0 {
+		return nil, nil
+	}
+
+	return edits[0], nil
+}
+
+// RawMetadata is the resolver for the rawMetadata field.
+func (r *mediaResolver) RawMetadata(ctx context.Context, obj *models.Media) (any, error) {
+	// exiftool can only read files, so media inside an archive are extracted for the query
+	filePath := obj.FilePath()
+	if _, _, inArchive := media_archive.Split(obj.Path); inArchive {
+		localPath, release, err := media_archive.Extract(obj.Path)
+		if err != nil {
+			return nil, fmt.Errorf("extract media %d from archive: %w", obj.ID, err)
+		}
+		defer release()
+		filePath = localPath
+	}
+
+	metadata, err := exif.RawMetadata(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("read metadata of media %d: %w", obj.ID, err)
+	}
+
+	return metadata, nil
+}
+
 // FavoriteMedia is the resolver for the favoriteMedia field.
 func (r *mutationResolver) FavoriteMedia(ctx context.Context, mediaID int, favorite bool) (*models.Media, error) {
 	user := auth.UserFromContext(ctx)
@@ -154,17 +337,254 @@ func (r *mutationResolver) FavoriteMedia(ctx context.Context, mediaID int, favor
 		return nil, auth.ErrUnauthorized
 	}
 
-	return user.FavoriteMedia(r.DB(ctx), mediaID, favorite)
+	db := r.DB(ctx)
+
+	media, err := user.FavoriteMedia(db, mediaID, favorite)
+	if err != nil {
+		return nil, err
+	}
+
+	writeBackXMP(ctx, db, []*models.Media{media}, scanner_tasks.XMPRemoved{})
+	return media, nil
+}
+
+// RateMedia is the resolver for the rateMedia field.
+func (r *mutationResolver) RateMedia(ctx context.Context, mediaIds []int, rating int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	media, err := user.RateMedia(db, mediaIds, rating)
+	if err != nil {
+		return nil, err
+	}
+
+	writeBackXMP(ctx, db, media, scanner_tasks.XMPRemoved{Rating: rating == models.MinRating})
+	return media, nil
+}
+
+// RejectMedia is the resolver for the rejectMedia field.
+func (r *mutationResolver) RejectMedia(ctx context.Context, mediaIds []int, rejected bool) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	media, err := user.RejectMedia(db, mediaIds, rejected)
+	if err != nil {
+		return nil, err
+	}
+
+	writeBackXMP(ctx, db, media, scanner_tasks.XMPRemoved{Rating: !rejected})
+	return media, nil
+}
+
+// SetVideoPosterFrame is the resolver for the setVideoPosterFrame field.
+func (r *mutationResolver) SetVideoPosterFrame(ctx context.Context, mediaID int, timestamp *float64) (*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	media, err := actions.SetVideoPosterFrame(db, user, mediaID, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Encode the new thumbnail right away, so it is returned with the media
+	if err := scanner.ProcessSingleMediaFunc(ctx, db, media); err != nil {
+		return nil, fmt.Errorf("encode video thumbnail for the new poster frame: %w", err)
+	}
+
+	return media, nil
+}
+
+// EditMedia is the resolver for the editMedia field.
+func (r *mutationResolver) EditMedia(ctx context.Context, mediaID int, input models.MediaEditInput) (*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	media, err := actions.EditMedia(db, user, mediaID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Encode the edited derivatives right away, so they are returned with the media
+	if err := scanner.ProcessSingleMediaFunc(ctx, db, media); err != nil {
+		return nil, fmt.Errorf("encode edited photo: %w", err)
+	}
+
+	return media, nil
+}
+
+// RevertMediaEdits is the resolver for the revertMediaEdits field.
+func (r *mutationResolver) RevertMediaEdits(ctx context.Context, mediaID int) (*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	media, err := actions.RevertMediaEdits(db, user, mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := scanner.ProcessSingleMediaFunc(ctx, db, media); err != nil {
+		return nil, fmt.Errorf("encode original photo: %w", err)
+	}
+
+	return media, nil
+}
+
+// SetMediaCaption is the resolver for the setMediaCaption field.
+func (r *mutationResolver) SetMediaCaption(ctx context.Context, mediaID int, caption *string) (*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.SetMediaCaption(r.DB(ctx), user, mediaID, caption)
+}
+
+// ShiftMediaDates is the resolver for the shiftMediaDates field.
+func (r *mutationResolver) ShiftMediaDates(ctx context.Context, mediaIds []int, albumID *int, offsetSeconds int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.ShiftMediaDates(r.DB(ctx), user, mediaIds, albumID, time.Duration(offsetSeconds)*time.Second)
+}
+
+// SetMediaDates is the resolver for the setMediaDates field.
+func (r *mutationResolver) SetMediaDates(ctx context.Context, mediaIds []int, albumID *int, date time.Time) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.SetMediaDates(r.DB(ctx), user, mediaIds, albumID, date)
+}
+
+// ResetMediaDates is the resolver for the resetMediaDates field.
+func (r *mutationResolver) ResetMediaDates(ctx context.Context, mediaIds []int, albumID *int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.ResetMediaDates(r.DB(ctx), user, mediaIds, albumID)
+}
+
+// SetMediaCoordinates is the resolver for the setMediaCoordinates field.
+func (r *mutationResolver) SetMediaCoordinates(ctx context.Context, mediaIds []int, latitude float64, longitude float64) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	media, err := actions.SetMediaCoordinates(db, user, mediaIds, latitude, longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	updateMediaPlaces(ctx, db, media)
+	return media, nil
+}
+
+// ClearMediaCoordinates is the resolver for the clearMediaCoordinates field.
+func (r *mutationResolver) ClearMediaCoordinates(ctx context.Context, mediaIds []int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	media, err := actions.ClearMediaCoordinates(db, user, mediaIds)
+	if err != nil {
+		return nil, err
+	}
+
+	updateMediaPlaces(ctx, db, media)
+	return media, nil
+}
+
+// ResetMediaCoordinates is the resolver for the resetMediaCoordinates field.
+func (r *mutationResolver) ResetMediaCoordinates(ctx context.Context, mediaIds []int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	media, err := actions.ResetMediaCoordinates(db, user, mediaIds)
+	if err != nil {
+		return nil, err
+	}
+
+	updateMediaPlaces(ctx, db, media)
+	return media, nil
+}
+
+// GeotagAlbumFromGpx is the resolver for the geotagAlbumFromGPX field.
+func (r *mutationResolver) GeotagAlbumFromGpx(ctx context.Context, albumID int, file graphql.Upload, offsetSeconds *int, maxGapSeconds *int, overwrite *bool) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	track, err := gpx.Parse(file.File)
+	if err != nil {
+		return nil, fmt.Errorf("read GPX file %q: %w", file.Filename, err)
+	}
+
+	var offset *time.Duration
+	if offsetSeconds != nil {
+		duration := time.Duration(*offsetSeconds) * time.Second
+		offset = &duration
+	}
+
+	maxGap := actions.DefaultGPXMaxGap
+	if maxGapSeconds != nil {
+		maxGap = time.Duration(*maxGapSeconds) * time.Second
+	}
+
+	db := r.DB(ctx)
+
+	media, err := actions.GeotagAlbumFromGPX(db, user, albumID, track, offset, maxGap, overwrite != nil && *overwrite)
+	if err != nil {
+		return nil, err
+	}
+
+	updateMediaPlaces(ctx, db, media)
+	return media, nil
 }
 
 // MyMedia is the resolver for the myMedia field.
-func (r *queryResolver) MyMedia(ctx context.Context, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+func (r *queryResolver) MyMedia(ctx context.Context, order *models.Ordering, paginate *models.Pagination, minRating *int) ([]*models.Media, error) {
 	user := auth.UserFromContext(ctx)
 	if user == nil {
 		return nil, fmt.Errorf("unauthorized")
 	}
 
-	return actions.MyMedia(r.DB(ctx), user, order, paginate)
+	return actions.MyMedia(r.DB(ctx), user, order, paginate, minRating)
 }
 
 // Media is the resolver for the media field.
@@ -0,0 +1,47 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/kkovaletp/photoview/api/dataloader"
+	"github.com/kkovaletp/photoview/api/graphql/auth"
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_tasks"
+	"gorm.io/gorm"
+)
+
+// userMediaData loads the data of the logged in user about the media, like its rating.
+func userMediaData(ctx context.Context, media *models.Media) (*models.UserMediaData, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return dataloader.For(ctx).UserMediaData.Load(&models.UserMediaData{
+		UserID:  user.ID,
+		MediaID: media.ID,
+	})
+}
+
+// writeBackXMP writes the curation of the media back to their XMP sidecars, failures are only logged.
+func writeBackXMP(ctx context.Context, db *gorm.DB, media []*models.Media, removed scanner_tasks.XMPRemoved) {
+	for _, m := range media {
+		if err := scanner_tasks.WriteBackXMP(db, m, removed); err != nil {
+			log.Warn(ctx, "Could not write curation back to XMP sidecar", "media_id", m.ID, "error", err)
+		}
+	}
+}
+
+// updateMediaPlaces resolves the places of the media after their coordinates changed, failures are only logged.
+func updateMediaPlaces(ctx context.Context, db *gorm.DB, media []*models.Media) {
+	for _, m := range media {
+		if m.Exif == nil {
+			continue
+		}
+
+		if err := scanner_tasks.SaveMediaPlace(db, m.ID, m.Exif.GPSLatitude, m.Exif.GPSLongitude); err != nil {
+			log.Warn(ctx, "Could not update place of media", "media_id", m.ID, "error", err)
+		}
+	}
+}
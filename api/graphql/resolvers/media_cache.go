@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner/media_cache"
+)
+
+// CheckMediaCache is the resolver for the checkMediaCache field.
+func (r *mutationResolver) CheckMediaCache(ctx context.Context, dryRun bool) (*models.MediaCacheCheckResult, error) {
+	return media_cache.CheckConsistency(r.DB(ctx), dryRun, func(media []*models.Media) error {
+		reprocessMediaInBackground(r.database, media)
+		return nil
+	})
+}
+
+// MediaCacheStats is the resolver for the mediaCacheStats field.
+func (r *queryResolver) MediaCacheStats(ctx context.Context) (*models.MediaCacheStats, error) {
+	return media_cache.ReadStats(r.DB(ctx))
+}
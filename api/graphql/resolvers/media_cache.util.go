@@ -0,0 +1,21 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/scanner"
+	"gorm.io/gorm"
+)
+
+// reprocessMediaInBackground generates the missing cache files of `media` one after the other, without blocking the request.
+func reprocessMediaInBackground(db *gorm.DB, media []*models.Media) {
+	go func() {
+		for _, m := range media {
+			if err := scanner.ProcessSingleMediaFunc(context.Background(), db, m); err != nil {
+				log.Error(nil, "Could not generate missing cache files of media", "media_id", m.ID, "error", err)
+			}
+		}
+	}()
+}
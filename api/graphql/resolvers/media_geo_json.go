@@ -11,6 +11,7 @@ import (
 	"path"
 
 	"github.com/kkovaletp/photoview/api/graphql/auth"
+	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/utils"
 )
 
@@ -33,7 +34,7 @@ func (r *queryResolver) MyMediaGeoJSON(ctx context.Context) (any, error) {
 		Joins("INNER JOIN user_albums ON media.album_id = user_albums.album_id").
 		Where("media_exif.gps_latitude IS NOT NULL").
 		Where("media_exif.gps_longitude IS NOT NULL").
-		Where("media_urls.purpose = 'thumbnail'").
+		Where("media_urls.purpose IN (?)", []models.MediaPurpose{models.PhotoThumbnail, models.VideoThumbnail}).
 		Where("user_albums.user_id = ?", user.ID).
 		Scan(&media).Error
 
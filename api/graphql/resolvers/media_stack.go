@@ -0,0 +1,64 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	api "github.com/kkovaletp/photoview/api/graphql"
+	"github.com/kkovaletp/photoview/api/graphql/auth"
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+)
+
+// Cover is the resolver for the cover field.
+func (r *mediaStackResolver) Cover(ctx context.Context, obj *models.MediaStack) (*models.Media, error) {
+	var cover models.Media
+	if err := r.DB(ctx).First(&cover, obj.CoverID).Error; err != nil {
+		return nil, err
+	}
+
+	return &cover, nil
+}
+
+// Media is the resolver for the media field.
+func (r *mediaStackResolver) Media(ctx context.Context, obj *models.MediaStack) ([]*models.Media, error) {
+	if obj.Members != nil {
+		return obj.Members, nil
+	}
+
+	var media []*models.Media
+	if err := r.DB(ctx).Where("stack_id = ?", obj.ID).Order("path").Find(&media).Error; err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// StackMedia is the resolver for the stackMedia field.
+func (r *mutationResolver) StackMedia(ctx context.Context, mediaIds []int, coverID *int) (*models.MediaStack, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.StackMedia(r.DB(ctx), user, mediaIds, coverID)
+}
+
+// UnstackMedia is the resolver for the unstackMedia field.
+func (r *mutationResolver) UnstackMedia(ctx context.Context, mediaIds []int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.UnstackMedia(r.DB(ctx), user, mediaIds)
+}
+
+// MediaStack returns api.MediaStackResolver implementation.
+func (r *Resolver) MediaStack() api.MediaStackResolver { return &mediaStackResolver{r} }
+
+type mediaStackResolver struct{ *Resolver }
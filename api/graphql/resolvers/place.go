@@ -0,0 +1,50 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	api "github.com/kkovaletp/photoview/api/graphql"
+	"github.com/kkovaletp/photoview/api/graphql/auth"
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+)
+
+// Cover is the resolver for the cover field.
+func (r *placeResolver) Cover(ctx context.Context, obj *models.Place) (*models.Media, error) {
+	var cover models.Media
+	if err := r.DB(ctx).First(&cover, obj.CoverID).Error; err != nil {
+		return nil, err
+	}
+
+	return &cover, nil
+}
+
+// Media is the resolver for the media field.
+func (r *placeResolver) Media(ctx context.Context, obj *models.Place, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.PlaceMedia(r.DB(ctx), user, obj.Filter(), order, paginate)
+}
+
+// MyPlaces is the resolver for the myPlaces field.
+func (r *queryResolver) MyPlaces(ctx context.Context) ([]*models.Place, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.MyPlaces(r.DB(ctx), user)
+}
+
+// Place returns api.PlaceResolver implementation.
+func (r *Resolver) Place() api.PlaceResolver { return &placeResolver{r} }
+
+type placeResolver struct{ *Resolver }
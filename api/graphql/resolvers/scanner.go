@@ -51,6 +51,58 @@ func (r *mutationResolver) ScanUser(ctx context.Context, userID int) (*models.Sc
 	}, nil
 }
 
+// RefreshMediaMetadata is the resolver for the refreshMediaMetadata field.
+func (r *mutationResolver) RefreshMediaMetadata(ctx context.Context, userID *int, albumID *int) (*models.ScannerResult, error) {
+	if (userID == nil) == (albumID == nil) {
+		return nil, errors.New("either userId or albumId must be given")
+	}
+
+	db := r.DB(ctx)
+	query := db.Model(&models.Media{})
+
+	if userID != nil {
+		var user models.User
+		if err := db.First(&user, *userID).Error; err != nil {
+			return nil, fmt.Errorf("get user from database: %w", err)
+		}
+
+		query = query.Where("album_id IN (?)", db.Table("user_albums").Select("album_id").Where("user_id = ?", user.ID))
+	} else {
+		var album models.Album
+		if err := db.First(&album, *albumID).Error; err != nil {
+			return nil, fmt.Errorf("get album from database: %w", err)
+		}
+
+		albums, err := album.GetChildren(db, nil)
+		if err != nil {
+			return nil, fmt.Errorf("get sub-albums from database: %w", err)
+		}
+
+		albumIDs := make([]int, len(albums))
+		for i, a := range albums {
+			albumIDs[i] = a.ID
+		}
+
+		query = query.Where("album_id IN (?)", albumIDs)
+	}
+
+	var media []*models.Media
+	if err := query.Find(&media).Error; err != nil {
+		return nil, fmt.Errorf("get media from database: %w", err)
+	}
+
+	if err := scanner_queue.AddMediaRefreshToQueue(media); err != nil {
+		return nil, fmt.Errorf("add media to the scanner queue: %w", err)
+	}
+
+	startMessage := fmt.Sprintf("Refreshing the metadata of %d media", len(media))
+	return &models.ScannerResult{
+		Finished: false,
+		Success:  true,
+		Message:  &startMessage,
+	}, nil
+}
+
 // SetPeriodicScanInterval is the resolver for the setPeriodicScanInterval field.
 func (r *mutationResolver) SetPeriodicScanInterval(ctx context.Context, interval int) (int, error) {
 	db := r.DB(ctx)
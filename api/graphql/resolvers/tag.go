@@ -0,0 +1,169 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/kkovaletp/photoview/api/graphql"
+	"github.com/kkovaletp/photoview/api/graphql/auth"
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_tasks"
+)
+
+// CreateTag is the resolver for the createTag field.
+func (r *mutationResolver) CreateTag(ctx context.Context, path string) (*models.Tag, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.CreateTag(r.DB(ctx), user, path)
+}
+
+// RenameTag is the resolver for the renameTag field.
+func (r *mutationResolver) RenameTag(ctx context.Context, tagID int, path string) (*models.Tag, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	tag, removed, err := actions.RenameTag(db, user, tagID, path)
+	if err != nil {
+		return nil, err
+	}
+
+	writeBackXMP(ctx, db, removed.Media, scanner_tasks.XMPRemoved{TagPaths: removed.Paths})
+	return tag, nil
+}
+
+// DeleteTag is the resolver for the deleteTag field.
+func (r *mutationResolver) DeleteTag(ctx context.Context, tagID int) (*models.Tag, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	tag, removed, err := actions.DeleteTag(db, user, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	writeBackXMP(ctx, db, removed.Media, scanner_tasks.XMPRemoved{TagPaths: removed.Paths})
+	return tag, nil
+}
+
+// TagMedia is the resolver for the tagMedia field.
+func (r *mutationResolver) TagMedia(ctx context.Context, mediaIds []int, path string) (*models.Tag, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	tag, media, err := actions.TagMedia(db, user, mediaIds, path)
+	if err != nil {
+		return nil, err
+	}
+
+	writeBackXMP(ctx, db, media, scanner_tasks.XMPRemoved{})
+	return tag, nil
+}
+
+// UntagMedia is the resolver for the untagMedia field.
+func (r *mutationResolver) UntagMedia(ctx context.Context, mediaIds []int, tagID int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	removed, err := actions.UntagMedia(db, user, mediaIds, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	writeBackXMP(ctx, db, removed.Media, scanner_tasks.XMPRemoved{TagPaths: removed.Paths})
+	return removed.Media, nil
+}
+
+// MyTags is the resolver for the myTags field.
+func (r *queryResolver) MyTags(ctx context.Context) ([]*models.Tag, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.MyTags(r.DB(ctx), user)
+}
+
+// Tag is the resolver for the tag field.
+func (r *queryResolver) Tag(ctx context.Context, id int) (*models.Tag, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.Tag(r.DB(ctx), user, id)
+}
+
+// Parent is the resolver for the parent field.
+func (r *tagResolver) Parent(ctx context.Context, obj *models.Tag) (*models.Tag, error) {
+	if obj.ParentID == nil {
+		return nil, nil
+	}
+
+	var parent models.Tag
+	if err := r.DB(ctx).First(&parent, *obj.ParentID).Error; err != nil {
+		return nil, fmt.Errorf("get parent of tag (%s): %w", obj.Path, err)
+	}
+
+	return &parent, nil
+}
+
+// Children is the resolver for the children field.
+func (r *tagResolver) Children(ctx context.Context, obj *models.Tag) ([]*models.Tag, error) {
+	var children []*models.Tag
+	if err := r.DB(ctx).Where("parent_id = ?", obj.ID).Order("path").Find(&children).Error; err != nil {
+		return nil, fmt.Errorf("get children of tag (%s): %w", obj.Path, err)
+	}
+
+	return children, nil
+}
+
+// MediaCount is the resolver for the mediaCount field.
+func (r *tagResolver) MediaCount(ctx context.Context, obj *models.Tag) (int, error) {
+	if obj.MediaCount == nil {
+		if err := models.FillTagMediaCounts(r.DB(ctx), obj.UserID, []*models.Tag{obj}); err != nil {
+			return 0, err
+		}
+	}
+
+	return *obj.MediaCount, nil
+}
+
+// Media is the resolver for the media field.
+func (r *tagResolver) Media(ctx context.Context, obj *models.Tag, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.TaggedMedia(r.DB(ctx), user, obj, order, paginate)
+}
+
+// Tag returns api.TagResolver implementation.
+func (r *Resolver) Tag() api.TagResolver { return &tagResolver{r} }
+
+type tagResolver struct{ *Resolver }
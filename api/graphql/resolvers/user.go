@@ -251,6 +251,26 @@ func (r *mutationResolver) UserRemoveRootAlbum(ctx context.Context, userID int,
 	return &album, nil
 }
 
+// SetRootAlbumScanArchives is the resolver for the setRootAlbumScanArchives field.
+func (r *mutationResolver) SetRootAlbumScanArchives(ctx context.Context, albumID int, scanArchives bool) (*models.Album, error) {
+	db := r.DB(ctx)
+
+	var album models.Album
+	if err := db.First(&album, albumID).Error; err != nil {
+		return nil, err
+	}
+
+	if album.ParentAlbumID != nil {
+		return nil, errors.New("archives can only be scanned for root albums")
+	}
+
+	if err := db.Model(&album).Update("scan_archives", scanArchives).Error; err != nil {
+		return nil, fmt.Errorf("failed to update scan archives of root album: %w", err)
+	}
+
+	return &album, nil
+}
+
 // ChangeUserPreferences is the resolver for the changeUserPreferences field.
 func (r *mutationResolver) ChangeUserPreferences(ctx context.Context, language *string) (*models.UserPreferences, error) {
 	db := r.DB(ctx)
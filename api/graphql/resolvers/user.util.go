@@ -1,13 +1,12 @@
 package resolvers
 
 import (
-	"os"
-	"path"
+	"context"
 	"strconv"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/scanner/face_detection"
-	"github.com/kkovaletp/photoview/api/utils"
+	"github.com/kkovaletp/photoview/api/storage"
 	"gorm.io/gorm"
 )
 
@@ -37,9 +36,7 @@ func clearCacheAndReloadFaces(db *gorm.DB, deletedAlbumIDs []int) error {
 	if deletedAlbumIDs != nil {
 		// Delete albums from cache
 		for _, id := range deletedAlbumIDs {
-			cacheAlbumPath := path.Join(utils.MediaCachePath(), strconv.Itoa(id))
-
-			if err := os.RemoveAll(cacheAlbumPath); err != nil {
+			if err := storage.Default().DeletePrefix(context.Background(), strconv.Itoa(id)); err != nil {
 				return err
 			}
 		}
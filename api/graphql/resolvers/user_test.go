@@ -0,0 +1,68 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetRootAlbumScanArchives(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	rootAlbum := models.Album{
+		Title: "root",
+		Path:  "/photos",
+	}
+	require.NoError(t, db.Create(&rootAlbum).Error)
+
+	subAlbum := models.Album{
+		Title:         "sub",
+		Path:          "/photos/sub",
+		ParentAlbumID: &rootAlbum.ID,
+	}
+	require.NoError(t, db.Create(&subAlbum).Error)
+
+	r := &mutationResolver{
+		Resolver: &Resolver{
+			database: db,
+		},
+	}
+
+	scanArchives := func(t *testing.T, albumID int) bool {
+		t.Helper()
+
+		var album models.Album
+		require.NoError(t, db.First(&album, albumID).Error)
+		return album.ScanArchives
+	}
+
+	t.Run("Enable for root album", func(t *testing.T) {
+		album, err := r.SetRootAlbumScanArchives(context.Background(), rootAlbum.ID, true)
+		require.NoError(t, err)
+		assert.True(t, album.ScanArchives)
+		assert.True(t, scanArchives(t, rootAlbum.ID))
+	})
+
+	t.Run("Disable for root album", func(t *testing.T) {
+		album, err := r.SetRootAlbumScanArchives(context.Background(), rootAlbum.ID, false)
+		require.NoError(t, err)
+		assert.False(t, album.ScanArchives)
+		assert.False(t, scanArchives(t, rootAlbum.ID))
+	})
+
+	t.Run("Rejected for sub album", func(t *testing.T) {
+		_, err := r.SetRootAlbumScanArchives(context.Background(), subAlbum.ID, true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "archives can only be scanned for root albums")
+		assert.False(t, scanArchives(t, subAlbum.ID))
+	})
+
+	t.Run("Unknown album", func(t *testing.T) {
+		_, err := r.SetRootAlbumScanArchives(context.Background(), subAlbum.ID+100, true)
+		assert.Error(t, err)
+	})
+}
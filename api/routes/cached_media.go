@@ -0,0 +1,117 @@
+package routes
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/scanner/media_archive"
+	"github.com/kkovaletp/photoview/api/storage"
+)
+
+// presignedURLExpiry is how long a client redirected to the storage of the media cache may download the file from there.
+const presignedURLExpiry = time.Hour
+
+// serveCachedFile writes the file `key` of the media cache to the response,
+// or redirects to the storage if it lets clients download files directly.
+func serveCachedFile(w http.ResponseWriter, r *http.Request, store storage.Storage, key string, contentType string) {
+	presignedURL, err := store.PresignedURL(r.Context(), key, presignedURLExpiry)
+	if err != nil {
+		log.Error(r.Context(), "could not presign URL of cached media", "key", key, "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(internalServerError))
+		return
+	}
+
+	if presignedURL != "" {
+		// Unlike the file itself, the redirect must not be cached for longer than the presigned URL is valid
+		w.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", int(presignedURLExpiry.Seconds())/2))
+		http.Redirect(w, r, presignedURL, http.StatusFound)
+		return
+	}
+
+	// Allow caching the resource
+	w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	if contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+
+	if localPath := store.LocalPath(key); localPath != "" {
+		http.ServeFile(w, r, localPath)
+		return
+	}
+
+	file, object, err := store.Open(r.Context(), key)
+	if err != nil {
+		log.Error(r.Context(), "could not open cached media", "key", key, "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(internalServerError))
+		return
+	}
+	defer file.Close()
+
+	http.ServeContent(w, r, path.Base(key), object.ModTime, file)
+}
+
+// serveVideoVTT writes the thumbnail track `key` of a video to the response. The track references the sprite sheet
+// `spriteURL` by its name, which is replaced by the URL of the sprite with the share token of the request, if any.
+// A name relative to the track would not be found if the client was redirected to the storage, and would lose the token.
+func serveVideoVTT(w http.ResponseWriter, r *http.Request, store storage.Storage, key string, contentType string,
+	spriteURL *models.MediaURL) {
+
+	file, _, err := store.Open(r.Context(), key)
+	if err != nil {
+		log.Error(r.Context(), "could not open cached thumbnail track", "key", key, "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(internalServerError))
+		return
+	}
+	defer file.Close()
+
+	vtt, err := io.ReadAll(file)
+	if err != nil {
+		log.Error(r.Context(), "could not read cached thumbnail track", "key", key, "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(internalServerError))
+		return
+	}
+
+	spriteLink := spriteURL.URL()
+	if token := r.URL.Query().Get("token"); token != "" {
+		spriteLink += "?token=" + url.QueryEscape(token)
+	}
+	vtt = bytes.ReplaceAll(vtt, []byte("\n"+spriteURL.MediaName+"#"), []byte("\n"+spriteLink+"#"))
+
+	// The track is small and holds the share token, so it is not cached for long
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	if contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.Write(vtt)
+}
+
+// openMediaFile opens the file of `mediaURL`, from the media cache unless it is the original media.
+func openMediaFile(r *http.Request, mediaURL *models.MediaURL) (io.ReadCloser, error) {
+	if mediaURL.Purpose == models.MediaOriginal {
+		cachedPath, err := mediaURL.CachedPath()
+		if err != nil {
+			return nil, err
+		}
+		file, _, err := media_archive.Open(cachedPath)
+		return file, err
+	}
+
+	key, err := mediaURL.CacheKey()
+	if err != nil {
+		return nil, err
+	}
+
+	file, _, err := storage.Default().Open(r.Context(), key)
+	return file, err
+}
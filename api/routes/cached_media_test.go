@@ -0,0 +1,99 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/storage"
+	"github.com/kkovaletp/photoview/api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// remoteTestStorage behaves like a remote storage, which has no local paths and may presign URLs.
+type remoteTestStorage struct {
+	storage.Storage
+	presignedURL string
+}
+
+func (s remoteTestStorage) LocalPath(key string) string {
+	return ""
+}
+
+func (s remoteTestStorage) PresignedURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	return s.presignedURL, nil
+}
+
+func TestServeCachedFile(t *testing.T) {
+	root := t.TempDir()
+	key := storage.MediaKey(1, 2, "video.mp4")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "1", "2"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "1", "2", "video.mp4"), []byte("0123456789"), 0644))
+
+	t.Run("Stream", func(t *testing.T) {
+		store := remoteTestStorage{Storage: storage.NewLocal(root)}
+
+		req := httptest.NewRequest("GET", "/video.mp4", nil)
+		req.Header.Set("Range", "bytes=2-5")
+		rec := httptest.NewRecorder()
+		serveCachedFile(rec, req, store, key, "video/mp4")
+
+		assert.Equal(t, http.StatusPartialContent, rec.Code)
+		assert.Equal(t, "2345", rec.Body.String())
+		assert.Equal(t, "video/mp4", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "private, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+	})
+
+	t.Run("Redirect", func(t *testing.T) {
+		store := remoteTestStorage{Storage: storage.NewLocal(root), presignedURL: "https://s3.example.com/media/1/2/video.mp4?X-Amz-Signature=abc"}
+
+		req := httptest.NewRequest("GET", "/video.mp4", nil)
+		rec := httptest.NewRecorder()
+		serveCachedFile(rec, req, store, key, "video/mp4")
+
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t, store.presignedURL, rec.Header().Get("Location"))
+		assert.Equal(t, "private, max-age=1800", rec.Header().Get("Cache-Control"))
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		store := remoteTestStorage{Storage: storage.NewLocal(root)}
+
+		rec := httptest.NewRecorder()
+		serveCachedFile(rec, httptest.NewRequest("GET", "/missing.mp4", nil), store, storage.MediaKey(1, 2, "missing.mp4"), "video/mp4")
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestServeVideoVTT(t *testing.T) {
+	root := t.TempDir()
+	key := storage.MediaKey(1, 2, "video_vtt.vtt")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "1", "2"), 0755))
+	vtt := "WEBVTT\n\n00:00:00.000 --> 00:00:05.000\nvideo_sprite.jpg#xywh=0,0,160,90\n"
+	require.NoError(t, os.WriteFile(filepath.Join(root, "1", "2", "video_vtt.vtt"), []byte(vtt), 0644))
+
+	apiEndpoint, err := url.Parse("https://photos.example.com/api")
+	require.NoError(t, err)
+	utils.ConfigureTestEndpoints(apiEndpoint, nil, nil)
+	defer utils.ResetTestEndpoints()
+
+	// The track is served even if the storage would redirect clients
+	store := remoteTestStorage{Storage: storage.NewLocal(root), presignedURL: "https://s3.example.com/media/1/2/video_vtt.vtt"}
+	spriteURL := &models.MediaURL{MediaName: "video_sprite.jpg", Purpose: models.VideoSprite}
+
+	rec := httptest.NewRecorder()
+	serveVideoVTT(rec, httptest.NewRequest("GET", "/video_vtt.vtt?token=abc", nil), store, key, "text/vtt", spriteURL)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/vtt", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "WEBVTT\n\n00:00:00.000 --> 00:00:05.000\nhttps://photos.example.com/api/photo/video_sprite.jpg?token=abc#xywh=0,0,160,90\n",
+		rec.Body.String())
+}
@@ -6,7 +6,6 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"os"
 	"strings"
 
 	"github.com/gorilla/mux"
@@ -74,15 +73,7 @@ func RegisterDownloadRoutes(db *gorm.DB, router *mux.Router) {
 				return
 			}
 
-			filePath, err := media.CachedPath()
-			if err != nil {
-				log.Printf("ERROR: Failed to get mediaURL cache path, when downloading album (%d): %v\n", album.ID, err)
-				w.WriteHeader(http.StatusInternalServerError)
-				w.Write([]byte(internalServerError))
-				return
-			}
-
-			fileData, err := os.Open(filePath)
+			fileData, err := openMediaFile(r, media)
 			if err != nil {
 				log.Printf("ERROR: Failed to open file to include in zip, when downloading album (%d): %v\n", album.ID, err)
 				w.WriteHeader(http.StatusInternalServerError)
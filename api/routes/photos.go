@@ -1,15 +1,19 @@
 package routes
 
 import (
+	"io/fs"
 	"net/http"
-	"os"
 
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/log"
 	"github.com/kkovaletp/photoview/api/scanner"
+	"github.com/kkovaletp/photoview/api/scanner/media_archive"
+	"github.com/kkovaletp/photoview/api/scanner/media_cache"
+	"github.com/kkovaletp/photoview/api/storage"
 )
 
 func RegisterPhotoRoutes(db *gorm.DB, router *mux.Router) {
@@ -41,28 +45,40 @@ func RegisterPhotoRoutes(db *gorm.DB, router *mux.Router) {
 			return
 		}
 
-		cachedPath, err := mediaURL.CachedPath()
+		if mediaURL.Purpose == models.MediaOriginal {
+			serveOriginalPhoto(w, r, db, &mediaURL)
+			return
+		}
+
+		key, err := mediaURL.CacheKey()
 		if err != nil {
-			log.Error(r.Context(), "error getting cached path for media URL", "error", err)
+			log.Error(r.Context(), "error getting cache key for media URL", "error", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(internalServerError))
 			return
 		}
 
-		if _, err := os.Stat(cachedPath); os.IsNotExist((err)) {
-			// err := db.Transaction(func(tx *gorm.DB) error {
+		store := storage.Default()
+		if _, err := store.Stat(r.Context(), key); err != nil {
+			if !storage.IsNotExist(err) {
+				log.Error(r.Context(), "cached image access error", "media_cache_key", key, "error", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(internalServerError))
+				return
+			}
+
 			if err = scanner.ProcessSingleMediaFunc(r.Context(), db, media); err != nil {
 				log.Error(r.Context(), "processing image not found in cache",
-					"media_cache_path", cachedPath,
+					"media_cache_key", key,
 					"error", err)
 				w.WriteHeader(http.StatusInternalServerError)
 				w.Write([]byte(internalServerError))
 				return
 			}
 
-			if _, err = os.Stat(cachedPath); err != nil {
+			if _, err = store.Stat(r.Context(), key); err != nil {
 				log.Error(r.Context(), "after reprocessing image not found in cache",
-					"media_cache_path", cachedPath,
+					"media_cache_key", key,
 					"error", err)
 				w.WriteHeader(http.StatusInternalServerError)
 				w.Write([]byte(internalServerError))
@@ -70,12 +86,57 @@ func RegisterPhotoRoutes(db *gorm.DB, router *mux.Router) {
 			}
 		}
 
-		// Allow caching the resource
-		w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
-		if mediaURL.ContentType != "" {
-			w.Header().Set("Content-Type", mediaURL.ContentType)
+		media_cache.RecordAccess(db, &mediaURL)
+
+		if mediaURL.Purpose == models.VideoVTT {
+			var spriteURL models.MediaURL
+			if err := db.Where("media_id = ? AND purpose = ?", media.ID, models.VideoSprite).First(&spriteURL).Error; err != nil {
+				log.Error(r.Context(), "sprite of thumbnail track not found", "media_cache_key", key, "error", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(internalServerError))
+				return
+			}
+
+			serveVideoVTT(w, r, store, key, mediaURL.ContentType, &spriteURL)
+			return
 		}
 
-		http.ServeFile(w, r, cachedPath)
+		serveCachedFile(w, r, store, key, mediaURL.ContentType)
 	})
 }
+
+// serveOriginalPhoto writes the original file of a photo to the response, which isn't stored in the media cache.
+func serveOriginalPhoto(w http.ResponseWriter, r *http.Request, db *gorm.DB, mediaURL *models.MediaURL) {
+	originalPath := mediaURL.Media.Path
+
+	if _, err := media_archive.Stat(originalPath); errors.Is(err, fs.ErrNotExist) {
+		if err = scanner.ProcessSingleMediaFunc(r.Context(), db, mediaURL.Media); err != nil {
+			log.Error(r.Context(), "processing original image not found", "path", originalPath, "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(internalServerError))
+			return
+		}
+	}
+
+	// Allow caching the resource
+	w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	if mediaURL.ContentType != "" {
+		w.Header().Set("Content-Type", mediaURL.ContentType)
+	}
+
+	if _, _, inArchive := media_archive.Split(originalPath); !inArchive {
+		http.ServeFile(w, r, originalPath)
+		return
+	}
+
+	file, info, err := media_archive.Open(originalPath)
+	if err != nil {
+		log.Error(r.Context(), "could not open original image in archive", "path", originalPath, "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(internalServerError))
+		return
+	}
+	defer file.Close()
+
+	http.ServeContent(w, r, info.Name(), info.ModTime(), file)
+}
@@ -3,15 +3,13 @@ package routes
 import (
 	"context"
 	"net/http"
-	"os"
-	"path"
-	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/log"
 	"github.com/kkovaletp/photoview/api/scanner"
-	"github.com/kkovaletp/photoview/api/utils"
+	"github.com/kkovaletp/photoview/api/scanner/media_cache"
+	"github.com/kkovaletp/photoview/api/storage"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
@@ -26,7 +24,7 @@ func handleVideoRequest(
 	db *gorm.DB,
 	mediaName string,
 	authenticateFn func(*models.Media, *gorm.DB, *http.Request) (bool, string, int, error),
-	getCachePathFn func(albumID, mediaID int, filename string) string,
+	store storage.Storage,
 ) {
 	var mediaURLs []models.MediaURL
 	if err := db.Model(&models.MediaURL{}).
@@ -64,11 +62,10 @@ func handleVideoRequest(
 		return
 	}
 
-	var cachedPath string
+	var key string
 
 	if mediaURL.Purpose == models.VideoWeb {
-		// Use the provided cache path function
-		cachedPath = getCachePathFn(int(media.AlbumID), int(mediaURL.MediaID), mediaURL.MediaName)
+		key = storage.MediaKey(media.AlbumID, mediaURL.MediaID, mediaURL.MediaName)
 	} else {
 		log.Error(r.Context(), "Can not handle media_purpose for video",
 			"purpose", mediaURL.Purpose,
@@ -78,8 +75,8 @@ func handleVideoRequest(
 		return
 	}
 
-	if _, err := os.Stat(cachedPath); err != nil {
-		if !os.IsNotExist(err) {
+	if _, err := store.Stat(r.Context(), key); err != nil {
+		if !storage.IsNotExist(err) {
 			log.Error(r.Context(), "cached video access error",
 				"error", err,
 				"media ID", media.ID,
@@ -107,7 +104,7 @@ func handleVideoRequest(
 			return
 		}
 
-		if _, err := os.Stat(cachedPath); err != nil {
+		if _, err := store.Stat(r.Context(), key); err != nil {
 			log.Error(r.Context(), "video not found in cache after reprocessing",
 				"error", err,
 				"media ID", media.ID,
@@ -118,20 +115,15 @@ func handleVideoRequest(
 		}
 	}
 
-	// Allow caching the resource
-	w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
-	w.Header().Set("Content-Type", mediaURL.ContentType)
-	http.ServeFile(w, r, cachedPath)
-}
+	media_cache.RecordAccess(db, &mediaURL)
 
-func generateCacheFilename(albumID, mediaID int, filename string) string {
-	return path.Join(utils.MediaCachePath(), strconv.Itoa(albumID), strconv.Itoa(mediaID), filename)
+	serveCachedFile(w, r, store, key, mediaURL.ContentType)
 }
 
 func RegisterVideoRoutes(db *gorm.DB, router *mux.Router) {
 
 	router.HandleFunc("/{name}", func(w http.ResponseWriter, r *http.Request) {
 		mediaName := mux.Vars(r)["name"]
-		handleVideoRequest(w, r, db, mediaName, authenticateMedia, generateCacheFilename)
+		handleVideoRequest(w, r, db, mediaName, authenticateMedia, storage.Default())
 	})
 }
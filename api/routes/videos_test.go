@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
-	"path"
 	"path/filepath"
 	"strconv"
 	"testing"
@@ -15,6 +14,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/graphql/models/actions"
+	"github.com/kkovaletp/photoview/api/storage"
 	"github.com/kkovaletp/photoview/api/test_utils"
 	"github.com/kkovaletp/photoview/api/utils"
 	"github.com/stretchr/testify/assert"
@@ -98,9 +98,7 @@ func registerMockVideoRoutesForTesting(db *gorm.DB, router *mux.Router, tempCach
 				return true, "success", http.StatusOK, nil
 			},
 			// Use test cache path
-			func(albumID, mediaID int, filename string) string {
-				return path.Join(tempCachePath, strconv.Itoa(albumID), strconv.Itoa(mediaID), filename)
-			},
+			storage.NewLocal(tempCachePath),
 		)
 	})
 }
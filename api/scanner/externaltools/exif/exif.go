@@ -2,6 +2,8 @@ package exif
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"sync"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
@@ -54,6 +56,12 @@ func Parse(filepath string) (*models.MediaEXIF, error) {
 		exiftool.PhotoMeta
 		exiftool.TimeAll
 		exiftool.GPS
+		exiftool.GPSDetails
+		exiftool.VideoLocation
+		exiftool.ImageSize
+		exiftool.MIMEType
+		exiftool.Burst
+		exiftool.Panorama
 	}
 	if err := globalExifParser.QueryJSONTagsByNumber(filepath, &values); err != nil {
 		return nil, err
@@ -61,9 +69,11 @@ func Parse(filepath string) (*models.MediaEXIF, error) {
 
 	values.PhotoMeta.SanitizeFloats()
 
+	maker, model := values.PhotoMeta.Camera()
+
 	ret := models.MediaEXIF{
-		Camera:          values.Model,
-		Maker:           values.Make,
+		Camera:          model,
+		Maker:           maker,
 		Lens:            values.LensModel,
 		Iso:             values.ISO,
 		Flash:           values.Flash,
@@ -73,26 +83,67 @@ func Parse(filepath string) (*models.MediaEXIF, error) {
 		Aperture:        values.Aperture,
 		FocalLength:     values.FocalLength,
 		Description:     values.ImageDescription,
+		BurstID:         values.Burst.ID(),
+
+		WhiteBalance:     values.WhiteBalance,
+		MeteringMode:     values.MeteringMode,
+		ColorSpace:       values.ColorSpace,
+		LensMaker:        values.LensMake.First(),
+		LensSerialNumber: values.LensSerialNumber.First(),
+		Software:         values.Software.First(),
+		Artist:           values.Artist.First(),
+		Copyright:        values.Copyright.First(),
+		ImageWidth:       values.ImageWidth,
+		ImageHeight:      values.ImageHeight,
 	}
 
 	dateShot := values.TimeAll.TimeInLocal()
 	if !dateShot.IsZero() {
 		ret.DateShot = new(dateShot)
+		ret.DateShotIsModTime = values.TimeAll.IsFileModifyDate()
 	}
 
 	offsetSec, ok := values.TimeAll.OffsetSecs(dateShot)
 	if ok {
 		ret.OffsetSecShot = &offsetSec
+	} else if isVideo(values.MIMEType.MIMEType) && values.TimeAll.IsQuickTimeDate() {
+		// The dates of QuickTime videos are in UTC. Without an offset they stay in UTC rather than local time,
+		// see the "Dates of Media" section of the README
+		ret.OffsetSecShot = new(0)
+	}
+
+	if projection := values.Panorama.Projection(); projection != nil {
+		ret.ProjectionType = projection
+		ret.FullPanoWidth = values.FullPanoWidthPixels
+		ret.FullPanoHeight = values.FullPanoHeightPixels
+		ret.CroppedAreaImageWidth = values.CroppedAreaImageWidthPixels
+		ret.CroppedAreaImageHeight = values.CroppedAreaImageHeightPixels
+		ret.CroppedAreaLeft = values.CroppedAreaLeftPixels
+		ret.CroppedAreaTop = values.CroppedAreaTopPixels
+		if heading := values.PoseHeadingDegrees; heading != nil && !math.IsNaN(*heading) {
+			ret.PoseHeading = heading
+		}
 	}
 
 	if values.GPS.IsValid() {
 		ret.GPSLatitude = values.GPS.GPSLatitude
 		ret.GPSLongitude = values.GPS.GPSLongitude
+		ret.GPSAltitude = values.GPSDetails.Altitude()
+		ret.GPSDirection = values.GPSDetails.Direction()
+	} else if position, altitude := values.VideoLocation.Position(); position.IsValid() {
+		ret.GPSLatitude = position.GPSLatitude
+		ret.GPSLongitude = position.GPSLongitude
+		ret.GPSAltitude = altitude
 	}
 
 	return &ret, nil
 }
 
+// isVideo reports whether the MIME type `mimeType` read by exiftool is the one of a video.
+func isVideo(mimeType *string) bool {
+	return mimeType != nil && strings.HasPrefix(*mimeType, "video/")
+}
+
 func MIMEType(filepath string) (string, error) {
 	globalMu.Lock()
 	defer globalMu.Unlock()
@@ -113,3 +164,153 @@ func MIMEType(filepath string) (string, error) {
 	return *mime.MIMEType, nil
 
 }
+
+// IsLivePhotoMotion reports whether the video `videoPath` is the motion of the still image `stillPath`,
+// see exiftool.LivePhoto.IsMotionOf.
+func IsLivePhotoMotion(stillPath string, videoPath string) (bool, error) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+
+	if globalExifParser == nil {
+		return false, fmt.Errorf("no exif parser initialized")
+	}
+
+	var still, video exiftool.LivePhoto
+	if err := globalExifParser.QueryJSONTagsByNumber(stillPath, &still); err != nil {
+		return false, err
+	}
+
+	if err := globalExifParser.QueryJSONTagsByNumber(videoPath, &video); err != nil {
+		return false, err
+	}
+
+	return video.IsMotionOf(still), nil
+}
+
+// SaveEmbeddedPreview saves the JPEG preview embedded in the RAW file `filepath` to `outputPath`, with the tags of the RAW file.
+// It returns false if the file has no embedded preview.
+func SaveEmbeddedPreview(filepath string, outputPath string) (bool, error) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+
+	if globalExifParser == nil {
+		return false, fmt.Errorf("no exif parser initialized")
+	}
+
+	return globalExifParser.SaveEmbeddedPreview(filepath, outputPath)
+}
+
+// ImageSize returns the dimension of the full image `filepath` as stored in its metadata, or zeros if unknown.
+func ImageSize(filepath string) (width, height int, err error) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+
+	if globalExifParser == nil {
+		return 0, 0, fmt.Errorf("no exif parser initialized")
+	}
+
+	var size exiftool.ImageSize
+	if err := globalExifParser.QueryJSONTagsByNumber(filepath, &size); err != nil {
+		return 0, 0, err
+	}
+
+	if size.ImageWidth == nil || size.ImageHeight == nil {
+		return 0, 0, nil
+	}
+
+	return *size.ImageWidth, *size.ImageHeight, nil
+}
+
+// RawMetadata returns all metadata exiftool finds in the media `filepath`, see exiftool.QueryAllTags.
+func RawMetadata(filepath string) (map[string]any, error) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+
+	if globalExifParser == nil {
+		return nil, fmt.Errorf("no exif parser initialized")
+	}
+
+	return globalExifParser.QueryAllTags(filepath)
+}
+
+// ParseXMP reads the descriptive metadata, like ratings and keywords, from the XMP and IPTC embedded in the media `filepath`.
+// The values found in the XMP sidecar file `sidecarPath` take precedence, unless the path is empty.
+func ParseXMP(filepath string, sidecarPath string) (*models.MediaXMP, error) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+
+	if globalExifParser == nil {
+		return nil, fmt.Errorf("no exif parser initialized")
+	}
+
+	var embedded exiftool.Descriptive
+	if err := globalExifParser.QueryJSONTagsByNumber(filepath, &embedded); err != nil {
+		return nil, err
+	}
+
+	ret := descriptiveXMP(embedded)
+
+	if sidecarPath != "" {
+		var sidecar exiftool.Descriptive
+		if err := globalExifParser.QueryJSONTagsByNumber(sidecarPath, &sidecar); err != nil {
+			return nil, fmt.Errorf("failed to parse XMP sidecar: %w", err)
+		}
+
+		mergeXMP(ret, descriptiveXMP(sidecar))
+	}
+
+	return ret, nil
+}
+
+func descriptiveXMP(values exiftool.Descriptive) *models.MediaXMP {
+	return &models.MediaXMP{
+		Rating:               values.RatingValue(),
+		Label:                values.LabelValue(),
+		Title:                values.TitleValue(),
+		Description:          values.DescriptionValue(),
+		Keywords:             values.KeywordsValue(),
+		HierarchicalSubjects: values.HierarchicalSubjectsValue(),
+		Creator:              values.CreatorValue(),
+		Copyright:            values.CopyrightValue(),
+	}
+}
+
+// mergeXMP overrides the values of `xmp` with the values set in `override`.
+func mergeXMP(xmp *models.MediaXMP, override *models.MediaXMP) {
+	for _, field := range []struct{ value, override **string }{
+		{&xmp.Label, &override.Label},
+		{&xmp.Title, &override.Title},
+		{&xmp.Description, &override.Description},
+		{&xmp.Creator, &override.Creator},
+		{&xmp.Copyright, &override.Copyright},
+	} {
+		if *field.override != nil {
+			*field.value = *field.override
+		}
+	}
+
+	if override.Rating != nil {
+		xmp.Rating = override.Rating
+	}
+
+	if len(override.Keywords) > 0 {
+		xmp.Keywords = override.Keywords
+	}
+
+	if len(override.HierarchicalSubjects) > 0 {
+		xmp.HierarchicalSubjects = override.HierarchicalSubjects
+	}
+}
+
+// WriteTags writes the tag assignments `tags`, like `-XMP-xmp:Rating=3`, to the file `filepath`.
+// An XMP sidecar file is created if it doesn't exist.
+func WriteTags(filepath string, tags ...string) error {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+
+	if globalExifParser == nil {
+		return fmt.Errorf("no exif parser initialized")
+	}
+
+	return globalExifParser.WriteTags(filepath, tags...)
+}
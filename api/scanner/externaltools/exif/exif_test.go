@@ -2,10 +2,12 @@ package exif
 
 import (
 	"os"
+	"slices"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/kkovaletp/photoview/api/graphql/models"
 	_ "github.com/kkovaletp/photoview/api/test_utils/flags"
 )
 
@@ -27,6 +29,43 @@ func TestParseWithoutInit(t *testing.T) {
 	}
 }
 
+func TestEmbeddedPreviewWithoutInit(t *testing.T) {
+	resetForTest()
+	if _, err := SaveEmbeddedPreview("./test_data/bird.jpg", t.TempDir()+"/preview.jpg"); err == nil {
+		t.Errorf("SaveEmbeddedPreview() without Init() doesn't return an error")
+	}
+	if _, _, err := ImageSize("./test_data/bird.jpg"); err == nil {
+		t.Errorf("ImageSize() without Init() doesn't return an error")
+	}
+}
+
+func TestImageSize(t *testing.T) {
+	resetForTest()
+
+	cleanup, err := Initialize()
+	if err != nil {
+		t.Fatalf("Initialize() error: %v", err)
+	}
+	defer cleanup()
+
+	width, height, err := ImageSize("./test_data/bird.jpg")
+	if err != nil {
+		t.Fatalf("ImageSize() returns an error: %v", err)
+	}
+
+	if width <= 0 || height <= 0 {
+		t.Errorf("ImageSize() = %dx%d, want a positive dimension", width, height)
+	}
+
+	saved, err := SaveEmbeddedPreview("./test_data/stripped.jpg", t.TempDir()+"/preview.jpg")
+	if err != nil {
+		t.Fatalf("SaveEmbeddedPreview() returns an error: %v", err)
+	}
+	if saved {
+		t.Errorf("SaveEmbeddedPreview() of a JPEG = true, want false")
+	}
+}
+
 func TestParse(t *testing.T) {
 	resetForTest()
 
@@ -139,3 +178,178 @@ func TestSamplesTime(t *testing.T) {
 		})
 	}
 }
+
+// checkValue reports an error if the value `got` of the field `name` isn't `want`, nil meaning missing
+func checkValue[T comparable](t *testing.T, name string, got *T, want *T) {
+	t.Helper()
+
+	switch {
+	case got == nil && want == nil:
+	case got == nil:
+		t.Errorf("%s = nil, want: %v", name, *want)
+	case want == nil:
+		t.Errorf("%s = %v, want: nil", name, *got)
+	case *got != *want:
+		t.Errorf("%s = %v, want: %v", name, *got, *want)
+	}
+}
+
+func TestSamplesDetails(t *testing.T) {
+	resetForTest()
+
+	cleanup, err := Initialize()
+	if err != nil {
+		t.Fatalf("Initialize() error: %v", err)
+	}
+	defer cleanup()
+
+	tests := []struct {
+		file             string
+		whiteBalance     *int64
+		meteringMode     *int64
+		colorSpace       *int64
+		lensMaker        *string
+		lensSerialNumber *string
+		software         *string
+		artist           *string
+		copyright        *string
+		imageWidth       *int
+		imageHeight      *int
+		gpsAltitude      *float64
+		gpsDirection     *float64
+	}{
+		{
+			file:        "./test_data/CorrectGPS.jpg",
+			imageWidth:  new(2608),
+			imageHeight: new(1626),
+			gpsAltitude: new(317.39),
+		},
+		{
+			file:         "./test_data/bad-exif.jpg",
+			whiteBalance: new(int64(0)),
+			meteringMode: new(int64(1)),
+			colorSpace:   new(int64(1)),
+			software:     new("GIMP 2.10.24"),
+			imageWidth:   new(128),
+			imageHeight:  new(96),
+		},
+		{
+			// The GPS position has an altitude reference, but no altitude
+			file:        "./test_data/bird.jpg",
+			colorSpace:  new(int64(1)),
+			imageWidth:  new(455),
+			imageHeight: new(350),
+		},
+		{
+			file:         "./test_data/sample1_nef.jpg",
+			whiteBalance: new(int64(0)),
+			meteringMode: new(int64(5)),
+			colorSpace:   new(int64(2)),
+			software:     new("Capture NX 2.0.0 M"),
+			artist:       new("Jason P. Odell"),
+			copyright:    new("© Jason P. Odell"),
+			imageWidth:   new(4256),
+			imageHeight:  new(2832),
+		},
+		{
+			file:        "./test_data/stripped.jpg",
+			imageWidth:  new(342),
+			imageHeight: new(256),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.file, func(t *testing.T) {
+			metadata, err := Parse(tc.file)
+			if err != nil {
+				t.Fatalf("Parse(%q) returns an error: %v", tc.file, err)
+			}
+
+			checkValue(t, "WhiteBalance", metadata.WhiteBalance, tc.whiteBalance)
+			checkValue(t, "MeteringMode", metadata.MeteringMode, tc.meteringMode)
+			checkValue(t, "ColorSpace", metadata.ColorSpace, tc.colorSpace)
+			checkValue(t, "LensMaker", metadata.LensMaker, tc.lensMaker)
+			checkValue(t, "LensSerialNumber", metadata.LensSerialNumber, tc.lensSerialNumber)
+			checkValue(t, "Software", metadata.Software, tc.software)
+			checkValue(t, "Artist", metadata.Artist, tc.artist)
+			checkValue(t, "Copyright", metadata.Copyright, tc.copyright)
+			checkValue(t, "ImageWidth", metadata.ImageWidth, tc.imageWidth)
+			checkValue(t, "ImageHeight", metadata.ImageHeight, tc.imageHeight)
+			checkValue(t, "GPSAltitude", metadata.GPSAltitude, tc.gpsAltitude)
+			checkValue(t, "GPSDirection", metadata.GPSDirection, tc.gpsDirection)
+		})
+	}
+}
+
+const testSidecar = `<?xpacket begin="" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/">
+ <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+  <rdf:Description rdf:about=""
+    xmlns:xmp="http://ns.adobe.com/xap/1.0/"
+    xmlns:dc="http://purl.org/dc/elements/1.1/"
+    xmlns:lr="http://ns.adobe.com/lightroom/1.0/"
+    xmp:Rating="4"
+    xmp:Label="Red">
+   <dc:title><rdf:Alt><rdf:li xml:lang="x-default">Bird</rdf:li></rdf:Alt></dc:title>
+   <dc:subject><rdf:Bag><rdf:li>bird</rdf:li><rdf:li>nature</rdf:li></rdf:Bag></dc:subject>
+   <lr:hierarchicalSubject><rdf:Bag><rdf:li>Animals|Birds</rdf:li></rdf:Bag></lr:hierarchicalSubject>
+   <dc:creator><rdf:Seq><rdf:li>Jane</rdf:li></rdf:Seq></dc:creator>
+  </rdf:Description>
+ </rdf:RDF>
+</x:xmpmeta>
+<?xpacket end="w"?>`
+
+func TestParseXMP(t *testing.T) {
+	resetForTest()
+
+	if _, err := ParseXMP("./test_data/bird.jpg", ""); err == nil {
+		t.Errorf("ParseXMP() without Init() doesn't return an error")
+	}
+
+	cleanup, err := Initialize()
+	if err != nil {
+		t.Fatalf("Initialize() error: %v", err)
+	}
+	defer cleanup()
+
+	sidecarPath := t.TempDir() + "/bird.xmp"
+	if err := os.WriteFile(sidecarPath, []byte(testSidecar), 0644); err != nil {
+		t.Fatalf("os.WriteFile() error: %v", err)
+	}
+
+	xmp, err := ParseXMP("./test_data/bird.jpg", sidecarPath)
+	if err != nil {
+		t.Fatalf("ParseXMP() returns an error: %v", err)
+	}
+
+	if xmp.Rating == nil || *xmp.Rating != 4 {
+		t.Errorf("xmp.Rating = %v, want: 4", xmp.Rating)
+	}
+	if xmp.Label == nil || *xmp.Label != "Red" {
+		t.Errorf("xmp.Label = %v, want: Red", xmp.Label)
+	}
+	if xmp.Title == nil || *xmp.Title != "Bird" {
+		t.Errorf("xmp.Title = %v, want: Bird", xmp.Title)
+	}
+	if got, want := xmp.Keywords, []string{"bird", "nature"}; !slices.Equal(got, want) {
+		t.Errorf("xmp.Keywords = %q, want: %q", got, want)
+	}
+	if got, want := xmp.HierarchicalSubjects, []string{"Animals|Birds"}; !slices.Equal(got, want) {
+		t.Errorf("xmp.HierarchicalSubjects = %q, want: %q", got, want)
+	}
+	if xmp.Creator == nil || *xmp.Creator != "Jane" {
+		t.Errorf("xmp.Creator = %v, want: Jane", xmp.Creator)
+	}
+}
+
+func TestMergeXMP(t *testing.T) {
+	xmp := &models.MediaXMP{Rating: new(2), Title: new("Embedded"), Copyright: new("Jane"), Keywords: []string{"embedded"}}
+	mergeXMP(xmp, &models.MediaXMP{Rating: new(-1), Title: new("Sidecar")})
+
+	if *xmp.Rating != -1 || *xmp.Title != "Sidecar" {
+		t.Errorf("values of the sidecar don't override the embedded values: %+v", xmp)
+	}
+	if xmp.Copyright == nil || !slices.Equal(xmp.Keywords, []string{"embedded"}) {
+		t.Errorf("embedded values missing from the sidecar are not kept: %+v", xmp)
+	}
+}
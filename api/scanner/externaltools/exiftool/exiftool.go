@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"io"
 	"os/exec"
+	"slices"
+	"strings"
 	"sync"
 )
 
@@ -204,6 +206,11 @@ func (e *Exiftool) rawSaveEmbedFile(outputPath string, args ...string) (hasEmbed
 }
 
 func (e *Exiftool) rawUpdateFile(args ...string) (err error) {
+	return e.rawWriteFile([]string{"1 image files updated"}, args...)
+}
+
+// rawWriteFile runs a writing command, which succeeded if its summary is one of `expectedOutputs`.
+func (e *Exiftool) rawWriteFile(expectedOutputs []string, args ...string) (err error) {
 	if err = e.rawSendCommand(args...); err != nil {
 		return
 	}
@@ -217,8 +224,9 @@ func (e *Exiftool) rawUpdateFile(args ...string) (err error) {
 		return
 	}
 
-	outStr := string(bytes.Trim(output, " \n\r\t"))
-	if outStr != "1 image files updated" {
+	// Summaries of several lines, like "0 image files updated\n    1 image files unchanged", are joined to a single line
+	outStr := strings.Join(strings.Fields(string(output)), " ")
+	if !slices.Contains(expectedOutputs, outStr) {
 		err = fmt.Errorf("invalid output: %s", outStr)
 		return
 	}
@@ -241,9 +249,69 @@ func (e *Exiftool) QueryJSONTagsByNumber(file string, value any) error {
 	return nil
 }
 
+// QueryAllTags returns all tags of `file` with human readable values, keyed by their family 1 group and name
+// like `ExifIFD:ExposureTime`. Binary values are replaced by a description of their size.
+func (e *Exiftool) QueryAllTags(file string) (map[string]any, error) {
+	var rows []map[string]any
+	if err := e.rawGetTags(&rows, "-a", "-G1", file); err != nil {
+		return nil, fmt.Errorf("query %q tags error: %w", file, err)
+	}
+
+	if len(rows) != 1 {
+		return nil, fmt.Errorf("query %q tags error: return %d responses, should be only 1", file, len(rows))
+	}
+
+	return rows[0], nil
+}
+
 // SaveJPEGPreview saves a preview jpeg from `src` to `previewOutput`.
 func (e *Exiftool) SaveJPEGPreview(src string, previewOutput string) (bool, error) {
-	saved, err := e.rawSaveEmbedFile(previewOutput, "-JpgFromRaw", src)
+	return e.saveEmbeddedJPEG(src, previewOutput, "-JpgFromRaw")
+}
+
+// SaveEmbeddedPreview saves the biggest jpeg preview embedded in the RAW file `src` to `previewOutput`,
+// trying `JpgFromRaw` first and `PreviewImage` otherwise. The tags of `src` are copied to the preview,
+// and the orientation of `src` is written explicitly, since the preview is stored unrotated.
+func (e *Exiftool) SaveEmbeddedPreview(src string, previewOutput string) (bool, error) {
+	for _, tag := range []string{"-JpgFromRaw", "-PreviewImage"} {
+		saved, err := e.saveEmbeddedJPEG(src, previewOutput, tag)
+		if err != nil {
+			return false, err
+		}
+
+		if !saved {
+			continue
+		}
+
+		if err := e.copyOrientation(src, previewOutput); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (e *Exiftool) copyOrientation(src string, dst string) error {
+	var meta struct{ Orientation *int64 }
+	if err := e.QueryJSONTagsByNumber(src, &meta); err != nil {
+		return err
+	}
+
+	if meta.Orientation == nil {
+		return nil
+	}
+
+	if err := e.WriteTags(dst, fmt.Sprintf("-Orientation#=%d", *meta.Orientation)); err != nil {
+		return fmt.Errorf("save orientation to jpeg preview for %q error: %w", src, err)
+	}
+
+	return nil
+}
+
+func (e *Exiftool) saveEmbeddedJPEG(src string, previewOutput string, tag string) (bool, error) {
+	saved, err := e.rawSaveEmbedFile(previewOutput, tag, src)
 	if err != nil {
 		return false, fmt.Errorf("save jpeg preview for %q error: %w", src, err)
 	}
@@ -258,3 +326,20 @@ func (e *Exiftool) SaveJPEGPreview(src string, previewOutput string) (bool, erro
 
 	return true, nil
 }
+
+// WriteTags writes the tag assignments `tags`, like `-XMP-xmp:Rating=3`, to `file`, which is overwritten.
+// An empty value, like `-XMP-xmp:Rating=`, deletes the tag. XMP sidecar files are created if they don't exist.
+func (e *Exiftool) WriteTags(file string, tags ...string) error {
+	args := append(append([]string{}, tags...), "-overwrite_original", file)
+	expectedOutputs := []string{
+		"1 image files updated",
+		"1 image files created",
+		"0 image files updated 1 image files unchanged",
+	}
+
+	if err := e.rawWriteFile(expectedOutputs, args...); err != nil {
+		return fmt.Errorf("write %q tags error: %w", file, err)
+	}
+
+	return nil
+}
@@ -373,6 +373,103 @@ func TestExiftoolSaveJPEGPreview(t *testing.T) {
 
 }
 
+func TestExiftoolSaveEmbeddedPreview(t *testing.T) {
+	tests := []struct {
+		file   string
+		wantOK bool
+	}{
+		{"./test_data/raw_with_preview_jpg.cr3", true},
+		{"./test_data/no_timezone.jpg", false},
+	}
+
+	instance, err := New()
+	if err != nil {
+		t.Fatalf("new error: %v", err)
+	}
+	defer instance.Close()
+
+	for _, tc := range tests {
+		t.Run(tc.file, func(t *testing.T) {
+			output := filepath.Join(t.TempDir(), "preview.jpg")
+			ok, err := instance.SaveEmbeddedPreview(tc.file, output)
+			if err != nil {
+				t.Fatalf("SaveEmbeddedPreview(%q, %q) error: %v", tc.file, output, err)
+			}
+
+			if ok != tc.wantOK {
+				t.Errorf("SaveEmbeddedPreview(%q, %q) = %v, want: %v", tc.file, output, ok, tc.wantOK)
+			}
+
+			if !ok {
+				return
+			}
+
+			var jpg struct {
+				MIMEType
+				PhotoMeta
+			}
+			if err := instance.QueryJSONTagsByNumber(output, &jpg); err != nil {
+				t.Fatalf("QueryJSONTagsByNumber(%q) error: %v", output, err)
+			}
+
+			if got, want := jpg.MIMEType.MIMEType, "image/jpeg"; got == nil || *got != want {
+				t.Errorf("MIMEType(%q) = %v, want: %q", output, got, want)
+			}
+
+			var raw struct{ PhotoMeta }
+			if err := instance.QueryJSONTagsByNumber(tc.file, &raw); err != nil {
+				t.Fatalf("QueryJSONTagsByNumber(%q) error: %v", tc.file, err)
+			}
+
+			if raw.Orientation != nil && (jpg.Orientation == nil || *jpg.Orientation != *raw.Orientation) {
+				t.Errorf("jpg.Orientation = %v, want: %d", jpg.Orientation, *raw.Orientation)
+			}
+		})
+	}
+}
+
+func TestExiftoolSaveEmbeddedPreviewRotated(t *testing.T) {
+	instance, err := New()
+	if err != nil {
+		t.Fatalf("new error: %v", err)
+	}
+	defer instance.Close()
+
+	data, err := os.ReadFile("./test_data/raw_with_preview_jpg.cr3")
+	if err != nil {
+		t.Fatalf("read raw sample error: %v", err)
+	}
+
+	dir := t.TempDir()
+	raw := filepath.Join(dir, "rotated.cr3")
+	if err := os.WriteFile(raw, data, 0644); err != nil {
+		t.Fatalf("write rotated raw error: %v", err)
+	}
+
+	for _, orientation := range []int64{6, 3, 1} {
+		t.Run(fmt.Sprint(orientation), func(t *testing.T) {
+			if err := instance.WriteTags(raw, fmt.Sprintf("-Orientation#=%d", orientation)); err != nil {
+				t.Fatalf("WriteTags(%q) error: %v", raw, err)
+			}
+
+			output := filepath.Join(dir, fmt.Sprintf("preview_%d.jpg", orientation))
+			ok, err := instance.SaveEmbeddedPreview(raw, output)
+			if err != nil || !ok {
+				t.Fatalf("SaveEmbeddedPreview(%q, %q) = %v, %v, want: true, nil", raw, output, ok, err)
+			}
+
+			var jpg struct{ PhotoMeta }
+			if err := instance.QueryJSONTagsByNumber(output, &jpg); err != nil {
+				t.Fatalf("QueryJSONTagsByNumber(%q) error: %v", output, err)
+			}
+
+			if jpg.Orientation == nil || *jpg.Orientation != orientation {
+				t.Errorf("jpg.Orientation = %v, want: %d", jpg.Orientation, orientation)
+			}
+		})
+	}
+}
+
 func TestExiftoolError(t *testing.T) {
 	instance, err := New()
 	if err != nil {
@@ -404,6 +501,9 @@ func TestExiftoolError(t *testing.T) {
 			output := filepath.Join(t.TempDir(), "output.jpg")
 			_, err = instance.SaveJPEGPreview(tc.file, output)
 			checkErr(err, "SaveJPEGPreview(%q, %q)", tc.file, output)
+
+			_, err = instance.SaveEmbeddedPreview(tc.file, output)
+			checkErr(err, "SaveEmbeddedPreview(%q, %q)", tc.file, output)
 		})
 	}
 }
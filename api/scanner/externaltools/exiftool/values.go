@@ -1,8 +1,12 @@
 package exiftool
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -42,6 +46,78 @@ func (gps GPS) String() string {
 	return fmt.Sprintf("GPS(%.9f, %.9f)", *gps.GPSLatitude, *gps.GPSLongitude)
 }
 
+// GPSDetails stores the gps-related tags besides the position.
+type GPSDetails struct {
+	GPSAltitude *float64
+	// GPSAltitudeRef is 1 if GPSAltitude is below the sea level
+	GPSAltitudeRef  *int
+	GPSImgDirection *float64
+}
+
+// Altitude returns the altitude in meters above the sea level, negative below it, or nil if unknown.
+func (d GPSDetails) Altitude() *float64 {
+	if d.GPSAltitude == nil || math.IsNaN(*d.GPSAltitude) || math.IsInf(*d.GPSAltitude, 0) {
+		return nil
+	}
+
+	altitude := *d.GPSAltitude
+	if d.GPSAltitudeRef != nil && *d.GPSAltitudeRef == 1 && altitude > 0 {
+		altitude = -altitude
+	}
+
+	return &altitude
+}
+
+// Direction returns the direction the camera was pointing to in degrees between 0 and 360, or nil if unknown.
+func (d GPSDetails) Direction() *float64 {
+	if d.GPSImgDirection == nil || math.IsNaN(*d.GPSImgDirection) || math.IsInf(*d.GPSImgDirection, 0) {
+		return nil
+	}
+
+	direction := math.Mod(*d.GPSImgDirection, 360)
+	if direction < 0 {
+		direction += 360
+	}
+
+	return &direction
+}
+
+// VideoLocation stores the position of videos, which QuickTime files hold as an ISO 6709 string
+// like `+37.7858-122.4064+010.000/`. exiftool returns it as numbers separated by spaces.
+type VideoLocation struct {
+	GPSCoordinates Strings
+}
+
+var iso6709 = regexp.MustCompile(`^([+-][0-9.]+)([+-][0-9.]+)([+-][0-9.]+)?`)
+
+// Position returns the position of the video, and its altitude in meters or nil if unknown.
+func (l VideoLocation) Position() (GPS, *float64) {
+	coordinates := l.GPSCoordinates.First()
+	if coordinates == nil {
+		return GPS{}, nil
+	}
+
+	var values []string
+	if match := iso6709.FindStringSubmatch(*coordinates); match != nil {
+		values = match[1:]
+	} else {
+		values = strings.FieldsFunc(*coordinates, func(r rune) bool { return r == ' ' || r == ',' })
+	}
+
+	numbers := make([]*float64, 3)
+	for i, value := range values {
+		if i >= len(numbers) || value == "" {
+			break
+		}
+
+		if number, err := strconv.ParseFloat(value, 64); err == nil && !math.IsNaN(number) && !math.IsInf(number, 0) {
+			numbers[i] = &number
+		}
+	}
+
+	return GPS{GPSLatitude: numbers[0], GPSLongitude: numbers[1]}, numbers[2]
+}
+
 // TimeAll stores tags returned by -time:all.
 type TimeAll struct {
 	SubSecDateTimeOriginal *string
@@ -52,6 +128,8 @@ type TimeAll struct {
 	TrackCreateDate  *string
 	MediaCreateDate  *string
 	FileModifyDate   *string
+	// CreationDate is written to videos by phones, in local time with a timezone
+	CreationDate *string
 
 	OffsetTimeOriginal *string
 	OffsetTime         *string
@@ -65,21 +143,46 @@ const layoutWithTimezone = "2006:01:02 15:04:05.999Z07:00"
 
 // TimeInLocal returns most likely time. The date and time are in local. The timezone is meaningless and always be in UTC. Use `OffsetSecs()` to determine the timezone.
 func (t TimeAll) TimeInLocal() time.Time {
-	for _, dateP := range []*string{
+	date, _ := t.timeInLocal()
+	return date
+}
+
+// IsFileModifyDate reports whether TimeInLocal is the modification time of the file, as the metadata has no date.
+func (t TimeAll) IsFileModifyDate() bool {
+	_, tag := t.timeInLocal()
+	return tag == "FileModifyDate"
+}
+
+// IsQuickTimeDate reports whether TimeInLocal is one of the dates of QuickTime videos, which are in UTC
+// instead of local time: CreateDate, TrackCreateDate or MediaCreateDate.
+func (t TimeAll) IsQuickTimeDate() bool {
+	_, tag := t.timeInLocal()
+	return tag == "CreateDate" || tag == "TrackCreateDate" || tag == "MediaCreateDate"
+}
+
+// timeInLocal returns the time for TimeInLocal, and the name of the tag it was read from.
+func (t TimeAll) timeInLocal() (time.Time, string) {
+	dates := []struct {
+		tag   string
+		value *string
+	}{
 		// Keep the order for the priority to generate DateShot
-		t.SubSecDateTimeOriginal,
-		t.SubSecCreateDate,
-		t.DateTimeOriginal,
-		t.CreateDate,
-		t.TrackCreateDate,
-		t.MediaCreateDate,
-		t.FileModifyDate,
-	} {
-		if dateP == nil {
+		{"SubSecDateTimeOriginal", t.SubSecDateTimeOriginal},
+		{"SubSecCreateDate", t.SubSecCreateDate},
+		{"DateTimeOriginal", t.DateTimeOriginal},
+		{"CreationDate", t.CreationDate},
+		{"CreateDate", t.CreateDate},
+		{"TrackCreateDate", t.TrackCreateDate},
+		{"MediaCreateDate", t.MediaCreateDate},
+		{"FileModifyDate", t.FileModifyDate},
+	}
+
+	for _, tagDate := range dates {
+		if tagDate.value == nil {
 			continue
 		}
 
-		date := *dateP
+		date := *tagDate.value
 
 		// Ignore timezone
 		if zoneIndex := strings.IndexAny(date, "+-Z"); zoneIndex >= 0 {
@@ -87,11 +190,11 @@ func (t TimeAll) TimeInLocal() time.Time {
 		}
 
 		if date, err := time.ParseInLocation(layout, date, time.UTC); err == nil {
-			return date
+			return date, tagDate.tag
 		}
 	}
 
-	return time.Time{}
+	return time.Time{}, ""
 }
 
 // OffsetSecs returns seconds offset by UTC.
@@ -115,6 +218,13 @@ func (t TimeAll) OffsetSecs(local time.Time) (int, bool) {
 		return *t.TimeZone * 60, true
 	}
 
+	if t.CreationDate != nil {
+		if date, err := time.Parse(layoutWithTimezone, *t.CreationDate); err == nil {
+			_, offsetSecs := date.Zone()
+			return offsetSecs, true
+		}
+	}
+
 	// Calculate offset sec with GPS time and local time.
 	if local.IsZero() {
 		return 0, false
@@ -148,6 +258,33 @@ type PhotoMeta struct {
 	ExposureTime     *float64
 	Aperture         *float64
 	FocalLength      *float64
+
+	WhiteBalance *int64
+	MeteringMode *int64
+	ColorSpace   *int64
+
+	// Text tags, which exiftool returns as numbers if they look like one, like serial numbers
+	LensMake         Strings
+	LensSerialNumber Strings
+	Software         Strings
+	Artist           Strings
+	Copyright        Strings
+	// AndroidMake and AndroidModel are written to videos by Android phones, instead of Make and Model
+	AndroidMake  Strings
+	AndroidModel Strings
+}
+
+// Camera returns the maker and the model of the camera.
+func (m PhotoMeta) Camera() (maker *string, model *string) {
+	maker, model = m.Make, m.Model
+	if maker == nil {
+		maker = m.AndroidMake.First()
+	}
+	if model == nil {
+		model = m.AndroidModel.First()
+	}
+
+	return maker, model
 }
 
 func (m *PhotoMeta) SanitizeFloats() {
@@ -166,6 +303,218 @@ func (m *PhotoMeta) SanitizeFloats() {
 	}
 }
 
+// Panorama stores the GPano XMP tags of panoramas and 360° photos.
+type Panorama struct {
+	ProjectionType               *string
+	FullPanoWidthPixels          *int
+	FullPanoHeightPixels         *int
+	CroppedAreaImageWidthPixels  *int
+	CroppedAreaImageHeightPixels *int
+	CroppedAreaLeftPixels        *int
+	CroppedAreaTopPixels         *int
+	PoseHeadingDegrees           *float64
+}
+
+// Projection returns the lower-cased projection type, like `equirectangular`, or nil if the image is no panorama.
+func (p Panorama) Projection() *string {
+	if p.ProjectionType == nil {
+		return nil
+	}
+
+	projection := strings.ToLower(strings.TrimSpace(*p.ProjectionType))
+	if projection == "" {
+		return nil
+	}
+
+	return &projection
+}
+
+// Burst stores tags shared by the photos taken in one burst.
+type Burst struct {
+	// BurstUUID is written by Apple devices
+	BurstUUID *string
+	// BurstID is written by Google cameras
+	BurstID *string
+}
+
+// ID returns the identifier of the burst, or nil if the photo isn't part of one.
+func (b Burst) ID() *string {
+	for _, id := range []*string{b.BurstUUID, b.BurstID} {
+		if id != nil && *id != "" {
+			return id
+		}
+	}
+
+	return nil
+}
+
+// ImageSize stores the dimension of the full image, for RAW files the size of the decoded sensor data.
+type ImageSize struct {
+	ImageWidth  *int
+	ImageHeight *int
+}
+
+// maxLivePhotoDuration is the longest video, in seconds, which is the motion of a still image without a content identifier
+// pairing them. The motion of Live Photos and motion photos lasts about 3 seconds.
+const maxLivePhotoDuration = 6
+
+// LivePhoto stores the tags pairing the still image and the video of a Live Photo.
+type LivePhoto struct {
+	// ContentIdentifier is shared by the still image and the video of an Apple Live Photo
+	ContentIdentifier *string
+	// Duration of videos in seconds
+	Duration *float64
+}
+
+// IsMotionOf reports whether the video is the motion of the still image `still` of the same name.
+// Videos are paired by the content identifier written by Apple devices if both files have one,
+// otherwise only if they are a few seconds long, so a movie isn't taken for the motion of its poster image.
+func (v LivePhoto) IsMotionOf(still LivePhoto) bool {
+	if v.ContentIdentifier != nil && still.ContentIdentifier != nil {
+		return *v.ContentIdentifier != "" && *v.ContentIdentifier == *still.ContentIdentifier
+	}
+
+	return v.Duration != nil && *v.Duration > 0 && *v.Duration <= maxLivePhotoDuration
+}
+
 type MIMEType struct {
 	MIMEType *string
 }
+
+// Strings is a tag which may hold several values. exiftool returns a single value as a scalar instead of an array,
+// and values looking like numbers as numbers.
+type Strings []string
+
+func (s *Strings) UnmarshalJSON(data []byte) error {
+	var values []json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		values = []json.RawMessage{data}
+	}
+
+	*s = make(Strings, 0, len(values))
+	for _, value := range values {
+		var text string
+		if err := json.Unmarshal(value, &text); err != nil {
+			var number json.Number
+			if err := json.Unmarshal(value, &number); err != nil {
+				return fmt.Errorf("tag value %s is neither a string nor a number", value)
+			}
+			text = number.String()
+		}
+
+		if text = strings.TrimSpace(text); text != "" {
+			*s = append(*s, text)
+		}
+	}
+
+	return nil
+}
+
+// First returns the first value, or nil if there is none.
+func (s Strings) First() *string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	return &s[0]
+}
+
+// Descriptive stores the tags describing a photo, written by photo managers like Lightroom and darktable
+// to the XMP and IPTC metadata. Several tags hold the same information, the first set one is used.
+type Descriptive struct {
+	Rating *float64
+	// Label is the color label
+	Label Strings
+
+	Title      Strings
+	ObjectName Strings
+
+	Description      Strings
+	CaptionAbstract  Strings `json:"Caption-Abstract"`
+	ImageDescription Strings
+
+	Subject             Strings
+	Keywords            Strings
+	HierarchicalSubject Strings
+
+	Creator Strings
+	Artist  Strings
+	ByLine  Strings `json:"By-line"`
+
+	Rights          Strings
+	Copyright       Strings
+	CopyrightNotice Strings
+}
+
+// first returns the first value of the first tag that is set.
+func first(tags ...Strings) *string {
+	for _, tag := range tags {
+		if value := tag.First(); value != nil {
+			return value
+		}
+	}
+
+	return nil
+}
+
+// RatingValue returns the star rating between 0 and 5, or -1 if the photo is rejected, or nil if it isn't rated.
+func (d Descriptive) RatingValue() *int {
+	if d.Rating == nil || math.IsNaN(*d.Rating) {
+		return nil
+	}
+
+	rating := int(math.Round(*d.Rating))
+	if rating < 0 {
+		rating = -1
+	}
+
+	return new(min(rating, 5))
+}
+
+// LabelValue returns the color label.
+func (d Descriptive) LabelValue() *string {
+	return d.Label.First()
+}
+
+// TitleValue returns the title.
+func (d Descriptive) TitleValue() *string {
+	return first(d.Title, d.ObjectName)
+}
+
+// DescriptionValue returns the caption.
+func (d Descriptive) DescriptionValue() *string {
+	return first(d.Description, d.CaptionAbstract, d.ImageDescription)
+}
+
+// KeywordsValue returns the flat keywords, without duplicates.
+func (d Descriptive) KeywordsValue() []string {
+	keywords := make([]string, 0, len(d.Subject)+len(d.Keywords))
+	for _, keyword := range append(append(Strings{}, d.Subject...), d.Keywords...) {
+		if !slices.Contains(keywords, keyword) {
+			keywords = append(keywords, keyword)
+		}
+	}
+
+	return keywords
+}
+
+// HierarchicalSubjectsValue returns the hierarchical keywords, with levels separated by `|` like "Places|Italy|Rome".
+func (d Descriptive) HierarchicalSubjectsValue() []string {
+	return append([]string{}, d.HierarchicalSubject...)
+}
+
+// CreatorValue returns the creators, joined by commas.
+func (d Descriptive) CreatorValue() *string {
+	for _, tag := range []Strings{d.Creator, d.Artist, d.ByLine} {
+		if len(tag) > 0 {
+			return new(strings.Join(tag, ", "))
+		}
+	}
+
+	return nil
+}
+
+// CopyrightValue returns the copyright notice.
+func (d Descriptive) CopyrightValue() *string {
+	return first(d.Rights, d.Copyright, d.CopyrightNotice)
+}
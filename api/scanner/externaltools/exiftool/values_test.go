@@ -1,7 +1,9 @@
 package exiftool
 
 import (
+	"encoding/json"
 	"math"
+	"slices"
 	"testing"
 	"time"
 )
@@ -39,6 +41,59 @@ func TestGPSIsValid(t *testing.T) {
 	}
 }
 
+func TestPanoramaProjection(t *testing.T) {
+	tests := []struct {
+		name     string
+		panorama Panorama
+		want     *string
+	}{
+		{"Empty", Panorama{}, nil},
+		{"Blank", Panorama{ProjectionType: new(" ")}, nil},
+		{"Equirectangular", Panorama{ProjectionType: new("equirectangular")}, new("equirectangular")},
+		{"Cylindrical", Panorama{ProjectionType: new("Cylindrical")}, new("cylindrical")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.panorama.Projection()
+			if (got == nil) != (tc.want == nil) || (got != nil && *got != *tc.want) {
+				t.Fatalf("panorama.Projection() = %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGPSDetails(t *testing.T) {
+	tests := []struct {
+		name          string
+		details       GPSDetails
+		wantAltitude  *float64
+		wantDirection *float64
+	}{
+		{"Empty", GPSDetails{}, nil, nil},
+		{"AboveSeaLevel", GPSDetails{GPSAltitude: new(120.5), GPSAltitudeRef: new(0), GPSImgDirection: new(90.0)}, new(120.5), new(90.0)},
+		{"BelowSeaLevel", GPSDetails{GPSAltitude: new(20.0), GPSAltitudeRef: new(1)}, new(-20.0), nil},
+		{"AlreadySigned", GPSDetails{GPSAltitude: new(-20.0), GPSAltitudeRef: new(1)}, new(-20.0), nil},
+		{"DirectionWrapped", GPSDetails{GPSImgDirection: new(-90.0)}, nil, new(270.0)},
+		{"NaN", GPSDetails{GPSAltitude: new(math.NaN()), GPSImgDirection: new(math.Inf(1))}, nil, nil},
+	}
+
+	equal := func(got, want *float64) bool {
+		return (got == nil) == (want == nil) && (got == nil || *got == *want)
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.details.Altitude(); !equal(got, tc.wantAltitude) {
+				t.Errorf("details.Altitude() = %v, want: %v", got, tc.wantAltitude)
+			}
+			if got := tc.details.Direction(); !equal(got, tc.wantDirection) {
+				t.Errorf("details.Direction() = %v, want: %v", got, tc.wantDirection)
+			}
+		})
+	}
+}
+
 func mustParseInUTC(t *testing.T, timeStr string) time.Time {
 	t.Helper()
 
@@ -91,6 +146,10 @@ func TestTimeAllTimeLocal(t *testing.T) {
 			if got := tc.timeAll.TimeInLocal(); !got.Equal(tc.want) {
 				t.Errorf("timeAll.Time() = %v, want: %v", got, tc.want)
 			}
+
+			if got, want := tc.timeAll.IsFileModifyDate(), tc.name == "FileModifyDate"; got != want {
+				t.Errorf("timeAll.IsFileModifyDate() = %v, want: %v", got, want)
+			}
 		})
 	}
 }
@@ -166,6 +225,15 @@ func TestTimeAllOffsetSecs(t *testing.T) {
 			TimeZone:    &otherTimezone,
 			GPSDateTime: &gpsDateTime,
 		}, -120 * 60},
+		{"TimeZone before CreationDate", TimeAll{
+			TimeZone:     &otherTimezone,
+			CreationDate: new("2025:10:28 13:20:22-03:00"),
+			GPSDateTime:  &gpsDateTime,
+		}, -120 * 60},
+		{"CreationDate", TimeAll{
+			CreationDate: new("2025:10:28 13:20:22-03:00"),
+			GPSDateTime:  &gpsDateTime,
+		}, -180 * 60},
 		{"GPS", TimeAll{
 			GPSDateTime: &gpsDateTime,
 		}, -60 * 60},
@@ -185,6 +253,114 @@ func TestTimeAllOffsetSecs(t *testing.T) {
 	}
 }
 
+func TestTimeAllVideoDates(t *testing.T) {
+	creationDate := "2025:10:28 15:20:22+02:00"
+	createDate := "2025:10:28 13:20:22"
+
+	tests := []struct {
+		name          string
+		timeAll       TimeAll
+		want          time.Time
+		wantQuickTime bool
+	}{
+		{"CreationDate", TimeAll{
+			CreationDate: &creationDate,
+			CreateDate:   &createDate,
+		}, mustParseInUTC(t, "2025:10:28 15:20:22"), false},
+		{"CreateDate", TimeAll{
+			CreateDate:     &createDate,
+			FileModifyDate: &creationDate,
+		}, mustParseInUTC(t, createDate), true},
+		{"MediaCreateDate", TimeAll{
+			MediaCreateDate: &createDate,
+		}, mustParseInUTC(t, createDate), true},
+		{"DateTimeOriginal", TimeAll{
+			DateTimeOriginal: &createDate,
+			CreateDate:       &createDate,
+		}, mustParseInUTC(t, createDate), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.timeAll.TimeInLocal(); !got.Equal(tc.want) {
+				t.Errorf("timeAll.Time() = %v, want: %v", got, tc.want)
+			}
+
+			if got := tc.timeAll.IsQuickTimeDate(); got != tc.wantQuickTime {
+				t.Errorf("timeAll.IsQuickTimeDate() = %v, want: %v", got, tc.wantQuickTime)
+			}
+		})
+	}
+}
+
+func TestVideoLocationPosition(t *testing.T) {
+	tests := []struct {
+		name         string
+		coordinates  Strings
+		wantGPS      string
+		wantAltitude *float64
+	}{
+		{"Empty", nil, "GPS(invalid)", nil},
+		{"ISO6709", Strings{"+37.7858-122.4064+010.000/"}, "GPS(37.785800000, -122.406400000)", new(10.0)},
+		{"ISO6709WithoutAltitude", Strings{"-33.8688+151.2093/"}, "GPS(-33.868800000, 151.209300000)", nil},
+		{"Numbers", Strings{"37.7858 -122.4064 -5"}, "GPS(37.785800000, -122.406400000)", new(-5.0)},
+		{"Invalid", Strings{"north"}, "GPS(invalid)", nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gps, altitude := VideoLocation{GPSCoordinates: tc.coordinates}.Position()
+			if got := gps.String(); got != tc.wantGPS {
+				t.Errorf("location.Position() = %s, want: %s", got, tc.wantGPS)
+			}
+
+			if (altitude == nil) != (tc.wantAltitude == nil) || (altitude != nil && *altitude != *tc.wantAltitude) {
+				t.Errorf("location.Position() altitude = %v, want: %v", altitude, tc.wantAltitude)
+			}
+		})
+	}
+}
+
+func TestPhotoMetaCamera(t *testing.T) {
+	android := PhotoMeta{AndroidMake: Strings{"Google"}, AndroidModel: Strings{"Pixel 7"}}
+	if maker, model := android.Camera(); *maker != "Google" || *model != "Pixel 7" {
+		t.Errorf("meta.Camera() = (%s, %s), want: (Google, Pixel 7)", *maker, *model)
+	}
+
+	apple := PhotoMeta{Make: new("Apple"), Model: new("iPhone 12"), AndroidModel: Strings{"Pixel 7"}}
+	if maker, model := apple.Camera(); *maker != "Apple" || *model != "iPhone 12" {
+		t.Errorf("meta.Camera() = (%s, %s), want: (Apple, iPhone 12)", *maker, *model)
+	}
+
+	if maker, model := (PhotoMeta{}).Camera(); maker != nil || model != nil {
+		t.Errorf("meta.Camera() = (%v, %v), want: (nil, nil)", maker, model)
+	}
+}
+
+func TestLivePhotoIsMotionOf(t *testing.T) {
+	tests := []struct {
+		name  string
+		video LivePhoto
+		still LivePhoto
+		want  bool
+	}{
+		{"SameContentIdentifier", LivePhoto{ContentIdentifier: new("A1"), Duration: new(3.0)}, LivePhoto{ContentIdentifier: new("A1")}, true},
+		{"OtherContentIdentifier", LivePhoto{ContentIdentifier: new("A1"), Duration: new(3.0)}, LivePhoto{ContentIdentifier: new("B2")}, false},
+		{"LongWithContentIdentifier", LivePhoto{ContentIdentifier: new("A1"), Duration: new(10.0)}, LivePhoto{ContentIdentifier: new("A1")}, true},
+		{"Short", LivePhoto{Duration: new(2.9)}, LivePhoto{ContentIdentifier: new("A1")}, true},
+		{"Movie", LivePhoto{Duration: new(95.0)}, LivePhoto{}, false},
+		{"NoDuration", LivePhoto{}, LivePhoto{}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.video.IsMotionOf(tc.still); got != tc.want {
+				t.Errorf("video.IsMotionOf() = %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestTimeAllOffsetSecsEmptyLocal(t *testing.T) {
 	timeAll := TimeAll{
 		GPSDateTime: new("14:20:22 2025:10:28Z"),
@@ -195,3 +371,104 @@ func TestTimeAllOffsetSecsEmptyLocal(t *testing.T) {
 		t.Errorf("timeAll.OffsetSecs() = (%d, %v), want: (%d, %v)", got, ok, 0, false)
 	}
 }
+
+func TestStringsUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want Strings
+	}{
+		{"Single", `"Italy"`, Strings{"Italy"}},
+		{"Array", `["Italy", "Rome"]`, Strings{"Italy", "Rome"}},
+		{"Numbers", `[2010, "Rome", 1.5]`, Strings{"2010", "Rome", "1.5"}},
+		{"Blank", `["", " "]`, Strings{}},
+		{"Null", `null`, Strings{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got Strings
+			if err := json.Unmarshal([]byte(tc.json), &got); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error: %v", tc.json, err)
+			}
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("json.Unmarshal(%s) = %q, want: %q", tc.json, got, tc.want)
+			}
+		})
+	}
+
+	var got Strings
+	if err := json.Unmarshal([]byte(`{"a": 1}`), &got); err == nil {
+		t.Errorf("json.Unmarshal() of an object doesn't return an error")
+	}
+}
+
+func TestDescriptiveValues(t *testing.T) {
+	var values Descriptive
+	err := json.Unmarshal([]byte(`{
+		"Rating": 4,
+		"Label": "Red",
+		"ObjectName": "Colosseum",
+		"Caption-Abstract": "The Colosseum at night",
+		"ImageDescription": "OLYMPUS DIGITAL CAMERA",
+		"Subject": ["Rome", "Italy"],
+		"Keywords": ["Italy", 2010],
+		"HierarchicalSubject": "Places|Italy|Rome",
+		"Artist": ["Jane", "John"],
+		"CopyrightNotice": "CC BY 4.0"
+	}`), &values)
+	if err != nil {
+		t.Fatalf("json.Unmarshal() error: %v", err)
+	}
+
+	if got := values.RatingValue(); got == nil || *got != 4 {
+		t.Errorf("RatingValue() = %v, want: 4", got)
+	}
+	if got := values.LabelValue(); got == nil || *got != "Red" {
+		t.Errorf("LabelValue() = %v, want: Red", got)
+	}
+	if got := values.TitleValue(); got == nil || *got != "Colosseum" {
+		t.Errorf("TitleValue() = %v, want: Colosseum", got)
+	}
+	if got := values.DescriptionValue(); got == nil || *got != "The Colosseum at night" {
+		t.Errorf("DescriptionValue() = %v, want the IPTC caption", got)
+	}
+	if got, want := values.KeywordsValue(), []string{"Rome", "Italy", "2010"}; !slices.Equal(got, want) {
+		t.Errorf("KeywordsValue() = %q, want: %q", got, want)
+	}
+	if got, want := values.HierarchicalSubjectsValue(), []string{"Places|Italy|Rome"}; !slices.Equal(got, want) {
+		t.Errorf("HierarchicalSubjectsValue() = %q, want: %q", got, want)
+	}
+	if got := values.CreatorValue(); got == nil || *got != "Jane, John" {
+		t.Errorf("CreatorValue() = %v, want: Jane, John", got)
+	}
+	if got := values.CopyrightValue(); got == nil || *got != "CC BY 4.0" {
+		t.Errorf("CopyrightValue() = %v, want: CC BY 4.0", got)
+	}
+
+	var empty Descriptive
+	if empty.RatingValue() != nil || empty.TitleValue() != nil || len(empty.KeywordsValue()) != 0 || empty.CreatorValue() != nil {
+		t.Errorf("values of empty tags are not empty")
+	}
+}
+
+func TestDescriptiveRatingValue(t *testing.T) {
+	tests := []struct {
+		rating float64
+		want   int
+	}{
+		{0, 0},
+		{3, 3},
+		{-1, -1},
+		{-5, -1},
+		{7, 5},
+	}
+
+	for _, tc := range tests {
+		values := Descriptive{Rating: &tc.rating}
+		if got := values.RatingValue(); got == nil || *got != tc.want {
+			t.Errorf("Descriptive{Rating: %v}.RatingValue() = %v, want: %d", tc.rating, got, tc.want)
+		}
+	}
+}
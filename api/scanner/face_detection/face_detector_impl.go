@@ -3,12 +3,14 @@
 package face_detection
 
 import (
+	"context"
 	"log"
 	"sync"
 
 	"github.com/Kagami/go-face"
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
+	"github.com/kkovaletp/photoview/api/storage"
 	"github.com/kkovaletp/photoview/api/utils"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -107,11 +109,17 @@ func (fd *faceDetector) DetectFaces(db *gorm.DB, media *models.Media) error {
 		return errors.New("thumbnail url is missing")
 	}
 
-	thumbnailPath, err := thumbnailURL.CachedPath()
+	thumbnailKey, err := thumbnailURL.CacheKey()
 	if err != nil {
 		return err
 	}
 
+	thumbnailPath, releaseThumbnail, err := storage.LocalCopy(context.Background(), thumbnailKey, "")
+	if err != nil {
+		return errors.Wrap(err, "get thumbnail from media cache")
+	}
+	defer releaseThumbnail()
+
 	fd.mutex.Lock()
 	faces, err := fd.rec.RecognizeFile(thumbnailPath)
 	fd.mutex.Unlock()
@@ -0,0 +1,281 @@
+// Package geocoding resolves the country, region and city of GPS coordinates offline.
+//
+// The places are looked up in a gazetteer of capitals and major cities embedded in Photoview,
+// or in the GeoNames dump in the directory set with PHOTOVIEW_GEONAMES_PATH. No network calls are made.
+package geocoding
+
+import (
+	"bufio"
+	"context"
+	"embed"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/utils"
+)
+
+//go:embed data/cities.tsv data/countries.tsv
+var embeddedData embed.FS
+
+const (
+	// CityRadius is the distance in km up to which a place is in its nearest city
+	CityRadius = 50.0
+	// CountryRadius is the distance in km up to which a place is in the country and region of its nearest city
+	// of a GeoNames dump, places farther away from any city, like on the open sea, are not resolved
+	CountryRadius = 300.0
+	// EmbeddedCountryRadius is CountryRadius for the embedded gazetteer. It only holds major cities, so the nearest
+	// one is often across a border for places farther away from them
+	EmbeddedCountryRadius = 30.0
+
+	earthRadius = 6371.0
+	kmPerDegree = earthRadius * math.Pi / 180
+)
+
+// Place is the location of coordinates. Region and City are empty if unknown.
+type Place struct {
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country, like `IT`
+	CountryCode string
+	Country     string
+	// Region is the first-level administrative division of the country, like a state or province
+	Region string
+	City   string
+}
+
+type city struct {
+	countryCode string
+	region      string
+	name        string
+	latitude    float64
+	longitude   float64
+}
+
+type cell struct {
+	latitude  int
+	longitude int
+}
+
+// gazetteer holds the known cities in cells of one degree for a fast lookup of the nearest one
+type gazetteer struct {
+	countries map[string]string
+	cells     map[cell][]city
+	// countryRadius is the distance in km up to which a place is in the country of its nearest city
+	countryRadius float64
+}
+
+var (
+	loadOnce sync.Once
+	loaded   *gazetteer
+)
+
+// Reverse returns the place at the coordinates, or nil if no city is within CountryRadius,
+// or EmbeddedCountryRadius for the embedded gazetteer.
+// The gazetteer is loaded on first use.
+func Reverse(latitude, longitude float64) *Place {
+	loadOnce.Do(func() {
+		loaded = load()
+	})
+
+	return loaded.reverse(latitude, longitude)
+}
+
+func (g *gazetteer) reverse(latitude, longitude float64) *Place {
+	if math.IsNaN(latitude) || math.IsNaN(longitude) || math.Abs(latitude) > 90 || math.Abs(longitude) > 180 {
+		return nil
+	}
+
+	nearest, distance := g.nearest(latitude, longitude, g.countryRadius)
+	if nearest == nil {
+		return nil
+	}
+
+	place := &Place{
+		CountryCode: nearest.countryCode,
+		Country:     g.countries[nearest.countryCode],
+		Region:      nearest.region,
+	}
+
+	if place.Country == "" {
+		place.Country = nearest.countryCode
+	}
+
+	if distance <= CityRadius {
+		place.City = nearest.name
+	}
+
+	return place
+}
+
+// nearest returns the city nearest to the coordinates within `radius` km and its distance.
+func (g *gazetteer) nearest(latitude, longitude, radius float64) (*city, float64) {
+	latitudeSpan := int(math.Ceil(radius / kmPerDegree))
+
+	// Degrees of longitude get shorter towards the poles, so more cells must be searched there
+	longitudeSpan := 180
+	if maxLatitude := math.Abs(latitude) + float64(latitudeSpan); maxLatitude < 89 {
+		longitudeSpan = min(180, int(math.Ceil(radius/(kmPerDegree*math.Cos(maxLatitude*math.Pi/180)))))
+	}
+
+	center := cellOf(latitude, longitude)
+	fromLongitude, toLongitude := center.longitude-longitudeSpan, center.longitude+longitudeSpan
+	if longitudeSpan >= 180 {
+		fromLongitude, toLongitude = -180, 179
+	}
+
+	var result *city
+	resultDistance := math.Inf(1)
+
+	for lat := center.latitude - latitudeSpan; lat <= center.latitude+latitudeSpan; lat++ {
+		for lon := fromLongitude; lon <= toLongitude; lon++ {
+			cities := g.cells[cell{latitude: lat, longitude: wrapLongitude(lon)}]
+			for j := range cities {
+				distance := haversine(latitude, longitude, cities[j].latitude, cities[j].longitude)
+				if distance <= radius && distance < resultDistance {
+					result = &cities[j]
+					resultDistance = distance
+				}
+			}
+		}
+	}
+
+	return result, resultDistance
+}
+
+func (g *gazetteer) add(c city) {
+	key := cellOf(c.latitude, c.longitude)
+	g.cells[key] = append(g.cells[key], c)
+}
+
+func cellOf(latitude, longitude float64) cell {
+	return cell{
+		latitude:  int(math.Floor(latitude)),
+		longitude: wrapLongitude(int(math.Floor(longitude))),
+	}
+}
+
+// wrapLongitude maps a longitude in degrees to the range [-180, 180)
+func wrapLongitude(longitude int) int {
+	return ((longitude+180)%360+360)%360 - 180
+}
+
+// haversine returns the distance in km between two coordinates on the earth
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	toRadians := math.Pi / 180
+	dLat := (lat2 - lat1) * toRadians
+	dLon := (lon2 - lon1) * toRadians
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*toRadians)*math.Cos(lat2*toRadians)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// load reads the GeoNames dump in PHOTOVIEW_GEONAMES_PATH if set, falling back to the embedded gazetteer.
+func load() *gazetteer {
+	if dir := utils.EnvGeoNamesPath.GetValue(); dir != "" {
+		g, err := loadGeoNames(dir)
+		if err == nil {
+			return g
+		}
+
+		log.Warn(context.Background(), "Failed to load GeoNames data, using the embedded places instead",
+			utils.EnvGeoNamesPath.GetName(), dir, "error", err)
+	}
+
+	g, err := loadEmbedded()
+	if err != nil {
+		// The embedded data is part of the binary, so this is a programming error
+		panic(err)
+	}
+
+	return g
+}
+
+// loadEmbedded reads the embedded gazetteer
+func loadEmbedded() (*gazetteer, error) {
+	g := &gazetteer{
+		countries:     make(map[string]string),
+		cells:         make(map[cell][]city),
+		countryRadius: EmbeddedCountryRadius,
+	}
+
+	countries, err := embeddedData.Open("data/countries.tsv")
+	if err != nil {
+		return nil, err
+	}
+	defer countries.Close()
+
+	err = readTSV(countries, func(fields []string) {
+		if len(fields) >= 2 {
+			g.countries[fields[0]] = fields[1]
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cities, err := embeddedData.Open("data/cities.tsv")
+	if err != nil {
+		return nil, err
+	}
+	defer cities.Close()
+
+	err = readTSV(cities, func(fields []string) {
+		if len(fields) < 5 {
+			return
+		}
+
+		if c, ok := parseCity(fields[0], fields[1], fields[2], fields[3], fields[4]); ok {
+			g.add(c)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// readTSV calls `parse` with the fields of each line of `r` separated by tabs, skipping empty lines and comments
+func readTSV(r io.Reader, parse func(fields []string)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parse(strings.Split(line, "\t"))
+	}
+
+	return scanner.Err()
+}
+
+func parseCity(countryCode, region, name, latitude, longitude string) (city, bool) {
+	lat, err := strconv.ParseFloat(latitude, 64)
+	if err != nil {
+		return city{}, false
+	}
+
+	lon, err := strconv.ParseFloat(longitude, 64)
+	if err != nil {
+		return city{}, false
+	}
+
+	if countryCode == "" || name == "" || math.Abs(lat) > 90 || math.Abs(lon) > 180 {
+		return city{}, false
+	}
+
+	return city{
+		countryCode: countryCode,
+		region:      region,
+		name:        name,
+		latitude:    lat,
+		longitude:   lon,
+	}, true
+}
@@ -0,0 +1,70 @@
+package geocoding
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		want      *Place
+	}{
+		{"Colosseum", 41.8902, 12.4922, &Place{CountryCode: "IT", Country: "Italy", Region: "Lazio", City: "Rome"}},
+		{"Central Park", 40.7812, -73.9665, &Place{CountryCode: "US", Country: "United States", Region: "New York", City: "New York City"}},
+		{"Sydney Opera House", -33.8568, 151.2153, &Place{CountryCode: "AU", Country: "Australia", Region: "New South Wales", City: "Sydney"}},
+		{"Fiji across the date line", -18.0, -179.9, nil},
+		{"Outback far from cities", -29.0, 136.5, nil},
+		{"Colmar, nearest to Freiburg across the border", 48.079, 7.358, nil},
+		{"Nova Gorica, nearest to Udine across the border", 45.956, 13.648, nil},
+		{"Mid Atlantic", 30.0, -40.0, nil},
+		{"Pacific", -40.0, -130.0, nil},
+		{"North Pole", 90.0, 0.0, nil},
+		{"Invalid", 120.0, 0.0, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Reverse(tc.latitude, tc.longitude))
+		})
+	}
+}
+
+func TestLoadGeoNames(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"countryInfo.txt": "#ISO\tISO3\tISO-Numeric\tfips\tCountry\n" +
+			"IT\tITA\t380\tIT\tItaly\n" +
+			"FJ\tFJI\t242\tFJ\tFiji\n",
+		"admin1CodesASCII.txt": "IT.07\tLazio\tLazio\t3174976\n" +
+			"FJ.03\tNorthern\tNorthern\t2194370\n",
+		"cities15000.txt": "3169070\tRoma\tRoma\t\t41.89193\t12.51133\tP\tPPLC\tIT\t\t07\n",
+		"cities500.txt": "3169070\tRome\tRome\tRoma\t41.89193\t12.51133\tP\tPPLC\tIT\t\t07\n" +
+			"3175030\tFrascati\tFrascati\t\t41.80903\t12.68049\tP\tPPL\tIT\t\t07\n" +
+			"2198148\tTaveuni\tTaveuni\t\t-16.85\t179.95\tP\tPPL\tFJ\t\t03\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+
+	g, err := loadGeoNames(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, &Place{CountryCode: "IT", Country: "Italy", Region: "Lazio", City: "Frascati"}, g.reverse(41.80, 12.69))
+	assert.Equal(t, &Place{CountryCode: "IT", Country: "Italy", Region: "Lazio", City: "Rome"}, g.reverse(41.90, 12.49),
+		"the most detailed cities file is used")
+	assert.Equal(t, &Place{CountryCode: "IT", Country: "Italy", Region: "Lazio"}, g.reverse(41.0, 13.5),
+		"places far from cities are in the country of the nearest one")
+	assert.Equal(t, &Place{CountryCode: "FJ", Country: "Fiji", Region: "Northern", City: "Taveuni"}, g.reverse(-16.85, -179.95),
+		"cities across the date line are found")
+
+	_, err = loadGeoNames(t.TempDir())
+	assert.Error(t, err, "a directory without cities file is rejected")
+}
@@ -0,0 +1,118 @@
+package geocoding
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// geoNamesCities matches the city files of GeoNames, like `cities1000.txt` with cities of at least 1000 inhabitants
+var geoNamesCities = regexp.MustCompile(`^cities(\d+)\.txt$`)
+
+// loadGeoNames reads the cities, regions and countries of the GeoNames dump in `dir`, downloaded from
+// https://download.geonames.org/export/dump/. Of several city files the most detailed one is used.
+func loadGeoNames(dir string) (*gazetteer, error) {
+	citiesPath, err := geoNamesCitiesPath(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &gazetteer{
+		countries:     make(map[string]string),
+		cells:         make(map[cell][]city),
+		countryRadius: CountryRadius,
+	}
+
+	// countryInfo.txt: ISO code, ISO3 code, numeric code, FIPS code, name, ...
+	err = readGeoNamesFile(filepath.Join(dir, "countryInfo.txt"), func(fields []string) {
+		if len(fields) > 4 {
+			g.countries[fields[0]] = fields[4]
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// admin1CodesASCII.txt: `<country code>.<admin1 code>`, name, ASCII name, geoname id
+	regions := make(map[string]string)
+	err = readGeoNamesFile(filepath.Join(dir, "admin1CodesASCII.txt"), func(fields []string) {
+		if len(fields) > 1 {
+			regions[fields[0]] = fields[1]
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// cities*.txt: geoname id, name, ASCII name, alternate names, latitude, longitude, feature class,
+	// feature code, country code, alternate country codes, admin1 code, ...
+	err = readGeoNamesFile(citiesPath, func(fields []string) {
+		if len(fields) <= 10 {
+			return
+		}
+
+		region := regions[fields[8]+"."+fields[10]]
+		if c, ok := parseCity(fields[8], region, fields[1], fields[4], fields[5]); ok {
+			g.add(c)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(g.cells) == 0 {
+		return nil, errors.Errorf("no cities found in %s", citiesPath)
+	}
+
+	return g, nil
+}
+
+// geoNamesCitiesPath returns the city file in `dir` with the lowest population limit
+func geoNamesCitiesPath(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", errors.Wrap(err, "read GeoNames directory")
+	}
+
+	bestName := ""
+	bestLimit := 0
+	for _, entry := range entries {
+		match := geoNamesCities.FindStringSubmatch(entry.Name())
+		if match == nil || entry.IsDir() {
+			continue
+		}
+
+		limit, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue
+		}
+
+		if bestName == "" || limit < bestLimit {
+			bestName = entry.Name()
+			bestLimit = limit
+		}
+	}
+
+	if bestName == "" {
+		return "", errors.Errorf("no cities file like cities1000.txt found in %s", dir)
+	}
+
+	return filepath.Join(dir, bestName), nil
+}
+
+func readGeoNamesFile(path string, parse func(fields []string)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "open GeoNames file")
+	}
+	defer file.Close()
+
+	if err := readTSV(file, parse); err != nil {
+		return errors.Wrapf(err, "read GeoNames file %s", path)
+	}
+
+	return nil
+}
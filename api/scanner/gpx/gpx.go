@@ -0,0 +1,138 @@
+// Package gpx reads GPS tracks from GPX files, to geotag media shot while the track was recorded.
+package gpx
+
+import (
+	"encoding/xml"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Point is a position of a track at a moment
+type Point struct {
+	Time      time.Time
+	Latitude  float64
+	Longitude float64
+}
+
+// Track is the list of positions of a GPX file ordered by time
+type Track struct {
+	Points []Point
+}
+
+type gpxFile struct {
+	Tracks []struct {
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+type gpxPoint struct {
+	Latitude  *float64 `xml:"lat,attr"`
+	Longitude *float64 `xml:"lon,attr"`
+	Time      string   `xml:"time"`
+}
+
+// validPosition reports whether the point has a latitude and longitude, which are numbers within their range
+func (p gpxPoint) validPosition() bool {
+	if p.Latitude == nil || p.Longitude == nil || math.IsNaN(*p.Latitude) || math.IsNaN(*p.Longitude) {
+		return false
+	}
+
+	return math.Abs(*p.Latitude) <= 90 && math.Abs(*p.Longitude) <= 180
+}
+
+// Parse reads the track points of the GPX file in `r`. Points without a time or a valid position are skipped,
+// an error is returned if no point is left.
+func Parse(r io.Reader) (*Track, error) {
+	var file gpxFile
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, errors.Wrap(err, "parse GPX file")
+	}
+
+	track := Track{Points: make([]Point, 0)}
+	for _, trk := range file.Tracks {
+		for _, segment := range trk.Segments {
+			for _, point := range segment.Points {
+				date, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(point.Time))
+				if err != nil {
+					continue
+				}
+
+				if !point.validPosition() {
+					continue
+				}
+
+				track.Points = append(track.Points, Point{
+					Time:      date.UTC(),
+					Latitude:  *point.Latitude,
+					Longitude: *point.Longitude,
+				})
+			}
+		}
+	}
+
+	if len(track.Points) == 0 {
+		return nil, errors.New("GPX file has no track points with a time")
+	}
+
+	sort.SliceStable(track.Points, func(i, j int) bool {
+		return track.Points[i].Time.Before(track.Points[j].Time)
+	})
+
+	return &track, nil
+}
+
+// Locate returns the position of the track at the moment `at`. Between two points at most `maxGap` apart,
+// the position is interpolated. Otherwise the nearest point is used if it is at most `maxGap` away.
+func (t *Track) Locate(at time.Time, maxGap time.Duration) (Point, bool) {
+	after := sort.Search(len(t.Points), func(i int) bool {
+		return !t.Points[i].Time.Before(at)
+	})
+
+	if after < len(t.Points) && t.Points[after].Time.Equal(at) {
+		return t.Points[after], true
+	}
+
+	var candidates []Point
+	if after > 0 {
+		candidates = append(candidates, t.Points[after-1])
+	}
+	if after < len(t.Points) {
+		candidates = append(candidates, t.Points[after])
+	}
+
+	if len(candidates) == 2 && candidates[1].Time.Sub(candidates[0].Time) <= maxGap &&
+		math.Abs(candidates[1].Longitude-candidates[0].Longitude) <= 180 {
+
+		return interpolate(candidates[0], candidates[1], at), true
+	}
+
+	var nearest Point
+	nearestGap := time.Duration(math.MaxInt64)
+	for _, point := range candidates {
+		gap := point.Time.Sub(at).Abs()
+		if gap <= maxGap && gap < nearestGap {
+			nearest = point
+			nearestGap = gap
+		}
+	}
+
+	return nearest, nearestGap <= maxGap
+}
+
+// interpolate returns the position between `from` and `to` at the moment `at`, moving linearly between them
+func interpolate(from, to Point, at time.Time) Point {
+	ratio := float64(at.Sub(from.Time)) / float64(to.Time.Sub(from.Time))
+
+	return Point{
+		Time:      at,
+		Latitude:  from.Latitude + (to.Latitude-from.Latitude)*ratio,
+		Longitude: from.Longitude + (to.Longitude-from.Longitude)*ratio,
+	}
+}
@@ -0,0 +1,88 @@
+package gpx
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk>
+    <name>Rome</name>
+    <trkseg>
+      <trkpt lat="41.9000" lon="12.5000"><ele>20</ele><time>2024-06-01T10:10:00Z</time></trkpt>
+      <trkpt lat="41.8900" lon="12.4900"><ele>21</ele><time>2024-06-01T10:00:00Z</time></trkpt>
+      <trkpt lat="41.9100" lon="12.5100"><ele>22</ele></trkpt>
+      <trkpt><ele>23</ele><time>2024-06-01T10:05:00Z</time></trkpt>
+      <trkpt lat="41.9200"><time>2024-06-01T10:06:00Z</time></trkpt>
+      <trkpt lat="NaN" lon="12.5000"><time>2024-06-01T10:07:00Z</time></trkpt>
+      <trkpt lat="41.9300" lon="NaN"><time>2024-06-01T10:08:00Z</time></trkpt>
+      <trkpt lat="41.9400" lon="Inf"><time>2024-06-01T10:09:00Z</time></trkpt>
+      <trkpt lat="-Inf" lon="12.5000"><time>2024-06-01T10:09:30Z</time></trkpt>
+      <trkpt lat="91" lon="12.5000"><time>2024-06-01T10:09:45Z</time></trkpt>
+    </trkseg>
+    <trkseg>
+      <trkpt lat="42.0000" lon="12.6000"><time>2024-06-01T12:00:00.500+02:00</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+func TestParse(t *testing.T) {
+	track, err := Parse(strings.NewReader(testGPX))
+	require.NoError(t, err)
+
+	require.Len(t, track.Points, 3, "points without time or a valid position are skipped")
+	assert.Equal(t, time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC), track.Points[0].Time, "points are ordered by time")
+	assert.Equal(t, time.Date(2024, 6, 1, 10, 0, 0, 500000000, time.UTC), track.Points[1].Time)
+	assert.Equal(t, 41.89, track.Points[0].Latitude)
+	assert.Equal(t, 12.49, track.Points[0].Longitude)
+
+	_, err = Parse(strings.NewReader(`<gpx><trk><trkseg><trkpt lat="1" lon="2"/></trkseg></trk></gpx>`))
+	assert.Error(t, err)
+
+	_, err = Parse(strings.NewReader(`<gpx><trk><trkseg><trkpt lat="NaN" lon="2"><time>2024-06-01T10:00:00Z</time></trkpt></trkseg></trk></gpx>`))
+	assert.Error(t, err, "a NaN position isn't a track point")
+
+	_, err = Parse(strings.NewReader(`not xml`))
+	assert.Error(t, err)
+}
+
+func TestLocate(t *testing.T) {
+	start := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	track := Track{Points: []Point{
+		{Time: start, Latitude: 10, Longitude: 20},
+		{Time: start.Add(10 * time.Minute), Latitude: 11, Longitude: 22},
+		{Time: start.Add(2 * time.Hour), Latitude: 12, Longitude: 24},
+	}}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want *Point
+	}{
+		{"exact point", start, &Point{Time: start, Latitude: 10, Longitude: 20}},
+		{"interpolated", start.Add(5 * time.Minute), &Point{Time: start.Add(5 * time.Minute), Latitude: 10.5, Longitude: 21}},
+		{"nearest point over a gap", start.Add(15 * time.Minute), &Point{Time: start.Add(10 * time.Minute), Latitude: 11, Longitude: 22}},
+		{"before the track", start.Add(-10 * time.Minute), &Point{Time: start, Latitude: 10, Longitude: 20}},
+		{"after the track", start.Add(2*time.Hour + 30*time.Minute), &Point{Time: start.Add(2 * time.Hour), Latitude: 12, Longitude: 24}},
+		{"middle of a gap", start.Add(time.Hour), nil},
+		{"long before the track", start.Add(-time.Hour), nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			point, ok := track.Locate(tc.at, 30*time.Minute)
+			if tc.want == nil {
+				assert.False(t, ok)
+				return
+			}
+
+			assert.True(t, ok)
+			assert.Equal(t, *tc.want, point)
+		})
+	}
+}
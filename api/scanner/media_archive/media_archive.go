@@ -0,0 +1,327 @@
+// Package media_archive reads media directly from ZIP archives, which the scanner shows as albums.
+//
+// Media inside an archive have a virtual path, which is the path of the archive joined with the name of the entry,
+// e.g. `/photos/2010.zip/holiday/IMG_0001.jpg`.
+package media_archive
+
+import (
+	"archive/zip"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Entry is a media file inside an archive.
+type Entry struct {
+	// Name is the path of the file inside the archive
+	Name string
+	// Path is the virtual path of the media, see the package documentation
+	Path string
+	Info fs.FileInfo
+	// file is set for the entries of an opened Archive
+	file *zip.File
+}
+
+// Archive is an opened archive, for reading many of its media without reading its list of files again for each one.
+type Archive struct {
+	path   string
+	reader *zip.ReadCloser
+}
+
+// OpenArchive opens the archive at `archivePath`. It must be closed when it's not needed anymore.
+func OpenArchive(archivePath string) (*Archive, error) {
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open archive %s", archivePath)
+	}
+
+	return &Archive{path: archivePath, reader: reader}, nil
+}
+
+// Close closes the archive, after which its entries can't be extracted anymore.
+func (a *Archive) Close() error {
+	return a.reader.Close()
+}
+
+// Entries lists the non-empty files of the archive, skipping hidden files and directories.
+func (a *Archive) Entries() []Entry {
+	entries := make([]Entry, 0, len(a.reader.File))
+	for _, file := range a.reader.File {
+		info := file.FileInfo()
+		if !info.Mode().IsRegular() || info.Size() == 0 || !validName(file.Name) {
+			continue
+		}
+
+		entries = append(entries, Entry{
+			Name: file.Name,
+			Path: path.Join(a.path, file.Name),
+			Info: info,
+			file: file,
+		})
+	}
+
+	return entries
+}
+
+// Extract writes the entry `entry` of the archive to a new temporary directory, like the Extract function.
+func (a *Archive) Extract(entry Entry) (localPath string, release func(), err error) {
+	file := entry.file
+	if file == nil {
+		if file, err = findFile(&a.reader.Reader, a.path, entry.Name); err != nil {
+			return "", func() {}, err
+		}
+	}
+
+	return extractToTemp(file, a.path, entry.Name)
+}
+
+// IsArchive reports whether `filePath` is a regular file the scanner can read media from.
+func IsArchive(filePath string) bool {
+	if !strings.EqualFold(path.Ext(filePath), ".zip") {
+		return false
+	}
+
+	info, err := os.Stat(filePath)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// Split returns the archive containing the media at `mediaPath` and the name of the media inside it.
+// It returns false if the media is not inside an archive.
+func Split(mediaPath string) (archivePath string, name string, ok bool) {
+	for archivePath = path.Dir(mediaPath); archivePath != "/" && archivePath != "."; archivePath = path.Dir(archivePath) {
+		if IsArchive(archivePath) {
+			return archivePath, strings.TrimPrefix(mediaPath, archivePath+"/"), true
+		}
+	}
+
+	return "", "", false
+}
+
+// validName reports whether the entry `name` is a non-hidden file, which doesn't point outside of the archive.
+func validName(name string) bool {
+	if !fs.ValidPath(name) || name == "." {
+		return false
+	}
+
+	for _, segment := range strings.Split(name, "/") {
+		if strings.HasPrefix(segment, ".") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Entries lists the non-empty files of the archive at `archivePath`, skipping hidden files and directories.
+// To read many of the entries, open the archive with OpenArchive instead.
+func Entries(archivePath string) ([]Entry, error) {
+	archive, err := OpenArchive(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	entries := archive.Entries()
+	for i := range entries {
+		// The entries are listed only, the archive they refer to is closed
+		entries[i].file = nil
+	}
+
+	return entries, nil
+}
+
+// Stat returns the file info of the media at `mediaPath`, which may be a regular file or inside an archive.
+func Stat(mediaPath string) (fs.FileInfo, error) {
+	archivePath, name, ok := Split(mediaPath)
+	if !ok {
+		return os.Stat(mediaPath)
+	}
+
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open archive %s", archivePath)
+	}
+	defer reader.Close()
+
+	file, err := findFile(&reader.Reader, archivePath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return file.FileInfo(), nil
+}
+
+// findFile returns the entry `name` of an archive.
+func findFile(reader *zip.Reader, archivePath string, name string) (*zip.File, error) {
+	for _, file := range reader.File {
+		if file.Name == name && validName(file.Name) {
+			return file, nil
+		}
+	}
+
+	return nil, errors.Wrapf(fs.ErrNotExist, "%s not found in archive %s", name, archivePath)
+}
+
+// entryReader is an opened media file of an archive.
+type entryReader struct {
+	io.ReadSeeker
+	close func() error
+}
+
+func (r entryReader) Close() error {
+	return r.close()
+}
+
+// Open opens the media at `mediaPath` for reading, which may be a regular file or inside an archive.
+// Media stored uncompressed are read from the archive directly,
+// compressed media are extracted to a temporary file which is removed when the reader is closed.
+func Open(mediaPath string) (io.ReadSeekCloser, fs.FileInfo, error) {
+	archivePath, name, ok := Split(mediaPath)
+	if !ok {
+		file, err := os.Open(mediaPath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		info, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return nil, nil, err
+		}
+
+		return file, info, nil
+	}
+
+	archiveFile, err := os.Open(archivePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader, info, err := openEntry(archiveFile, archivePath, name)
+	if err != nil {
+		archiveFile.Close()
+		return nil, nil, err
+	}
+
+	return reader, info, nil
+}
+
+// openEntry opens the entry `name` of the archive opened as `archiveFile`.
+// The returned reader closes the archive file when it is closed.
+func openEntry(archiveFile *os.File, archivePath string, name string) (io.ReadSeekCloser, fs.FileInfo, error) {
+	archiveInfo, err := archiveFile.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader, err := zip.NewReader(archiveFile, archiveInfo.Size())
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "read archive %s", archivePath)
+	}
+
+	file, err := findFile(reader, archivePath, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if file.Method == zip.Store {
+		offset, err := file.DataOffset()
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "locate %s in archive %s", name, archivePath)
+		}
+
+		section := io.NewSectionReader(archiveFile, offset, int64(file.UncompressedSize64))
+		return entryReader{ReadSeeker: section, close: archiveFile.Close}, file.FileInfo(), nil
+	}
+
+	tempFile, err := os.CreateTemp("", "photoview-archive-*"+path.Ext(name))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "create temporary file")
+	}
+	closeTempFile := func() error {
+		defer os.Remove(tempFile.Name())
+		return tempFile.Close()
+	}
+
+	if err := extractFile(file, tempFile); err != nil {
+		closeTempFile()
+		return nil, nil, errors.Wrapf(err, "extract %s from archive %s", name, archivePath)
+	}
+
+	if _, err := tempFile.Seek(0, io.SeekStart); err != nil {
+		closeTempFile()
+		return nil, nil, err
+	}
+
+	archiveFile.Close()
+	return entryReader{ReadSeeker: tempFile, close: closeTempFile}, file.FileInfo(), nil
+}
+
+// extractFile writes the decompressed content of the archive entry `file` to `out`.
+func extractFile(file *zip.File, out io.Writer) error {
+	in, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// Extract writes the media at `mediaPath` inside an archive to a new temporary directory,
+// for the tools which can only read files. The file keeps the name of the media, so its extension is preserved.
+// The returned function removes the extracted file, and must be called when it's not needed anymore.
+func Extract(mediaPath string) (localPath string, release func(), err error) {
+	archivePath, name, ok := Split(mediaPath)
+	if !ok {
+		return "", func() {}, errors.Errorf("%s is not inside an archive", mediaPath)
+	}
+
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return "", func() {}, errors.Wrapf(err, "open archive %s", archivePath)
+	}
+	defer reader.Close()
+
+	file, err := findFile(&reader.Reader, archivePath, name)
+	if err != nil {
+		return "", func() {}, err
+	}
+
+	return extractToTemp(file, archivePath, name)
+}
+
+// extractToTemp writes the entry `file` named `name` of the archive at `archivePath` to a new temporary directory.
+func extractToTemp(file *zip.File, archivePath string, name string) (localPath string, release func(), err error) {
+	dir, err := os.MkdirTemp("", "photoview-archive-")
+	if err != nil {
+		return "", func() {}, errors.Wrap(err, "create temporary directory")
+	}
+	release = func() { os.RemoveAll(dir) }
+
+	localPath = filepath.Join(dir, path.Base(name))
+	out, err := os.Create(localPath)
+	if err != nil {
+		release()
+		return "", func() {}, err
+	}
+
+	if err := extractFile(file, out); err != nil {
+		out.Close()
+		release()
+		return "", func() {}, errors.Wrapf(err, "extract %s from archive %s", name, archivePath)
+	}
+
+	if err := out.Close(); err != nil {
+		release()
+		return "", func() {}, err
+	}
+
+	return localPath, release, nil
+}
@@ -0,0 +1,168 @@
+package media_archive
+
+import (
+	"archive/zip"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestArchive writes an archive with a stored and a compressed photo, and files which aren't media.
+func writeTestArchive(t *testing.T) string {
+	t.Helper()
+
+	archivePath := filepath.Join(t.TempDir(), "export.zip")
+	file, err := os.Create(archivePath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	files := []struct {
+		name    string
+		method  uint16
+		content string
+	}{
+		{"stored.jpg", zip.Store, "0123456789"},
+		{"holiday/compressed.jpg", zip.Deflate, "abcdefghij"},
+		{"holiday/", zip.Store, ""},
+		{"empty.jpg", zip.Store, ""},
+		{".hidden.jpg", zip.Store, "hidden"},
+		{"__MACOSX/.hidden/stored.jpg", zip.Store, "hidden"},
+		{"../outside.jpg", zip.Store, "outside"},
+	}
+
+	for _, f := range files {
+		entry, err := writer.CreateHeader(&zip.FileHeader{Name: f.name, Method: f.method})
+		require.NoError(t, err)
+		_, err = entry.Write([]byte(f.content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, writer.Close())
+	return archivePath
+}
+
+func TestEntries(t *testing.T) {
+	archivePath := writeTestArchive(t)
+
+	entries, err := Entries(archivePath)
+	require.NoError(t, err)
+
+	paths := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		paths = append(paths, entry.Path)
+	}
+	assert.Equal(t, []string{
+		filepath.Join(archivePath, "stored.jpg"),
+		filepath.Join(archivePath, "holiday/compressed.jpg"),
+	}, paths)
+
+	_, err = Entries(filepath.Join(t.TempDir(), "missing.zip"))
+	assert.Error(t, err)
+}
+
+func TestOpenArchive(t *testing.T) {
+	archivePath := writeTestArchive(t)
+
+	archive, err := OpenArchive(archivePath)
+	require.NoError(t, err)
+	defer archive.Close()
+
+	entries := archive.Entries()
+	require.Len(t, entries, 2)
+
+	for _, entry := range entries {
+		localPath, release, err := archive.Extract(entry)
+		require.NoError(t, err)
+		assert.Equal(t, path.Base(entry.Name), filepath.Base(localPath))
+
+		content, err := os.ReadFile(localPath)
+		require.NoError(t, err)
+		assert.Len(t, content, 10)
+
+		release()
+	}
+
+	listed, err := Entries(archivePath)
+	require.NoError(t, err)
+
+	localPath, release, err := archive.Extract(listed[1])
+	require.NoError(t, err, "entries listed without the archive are found by name")
+	defer release()
+	assert.Equal(t, "compressed.jpg", filepath.Base(localPath))
+
+	_, err = OpenArchive(filepath.Join(t.TempDir(), "missing.zip"))
+	assert.Error(t, err)
+}
+
+func TestSplit(t *testing.T) {
+	archivePath := writeTestArchive(t)
+
+	archive, name, ok := Split(archivePath + "/holiday/compressed.jpg")
+	assert.True(t, ok)
+	assert.Equal(t, archivePath, archive)
+	assert.Equal(t, "holiday/compressed.jpg", name)
+
+	_, _, ok = Split(filepath.Join(filepath.Dir(archivePath), "photo.jpg"))
+	assert.False(t, ok)
+
+	dirNamedLikeArchive := filepath.Join(t.TempDir(), "photos.zip")
+	require.NoError(t, os.Mkdir(dirNamedLikeArchive, 0755))
+	_, _, ok = Split(filepath.Join(dirNamedLikeArchive, "photo.jpg"))
+	assert.False(t, ok, "only files are archives")
+}
+
+func TestOpen(t *testing.T) {
+	archivePath := writeTestArchive(t)
+
+	for _, name := range []string{"stored.jpg", "holiday/compressed.jpg"} {
+		t.Run(name, func(t *testing.T) {
+			reader, info, err := Open(archivePath + "/" + name)
+			require.NoError(t, err)
+			defer reader.Close()
+
+			assert.Equal(t, int64(10), info.Size())
+
+			_, err = reader.Seek(4, io.SeekStart)
+			require.NoError(t, err)
+			content, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Len(t, content, 6)
+		})
+	}
+
+	_, _, err := Open(archivePath + "/missing.jpg")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	_, _, err = Open(archivePath + "/.hidden.jpg")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	info, err := Stat(archivePath + "/holiday/compressed.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, "compressed.jpg", info.Name())
+}
+
+func TestExtract(t *testing.T) {
+	archivePath := writeTestArchive(t)
+
+	localPath, release, err := Extract(archivePath + "/holiday/compressed.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, "compressed.jpg", filepath.Base(localPath))
+
+	content, err := os.ReadFile(localPath)
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefghij", string(content))
+
+	release()
+	assert.NoFileExists(t, localPath)
+
+	_, release, err = Extract(filepath.Join(t.TempDir(), "photo.jpg"))
+	assert.Error(t, err)
+	release()
+}
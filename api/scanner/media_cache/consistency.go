@@ -0,0 +1,196 @@
+package media_cache
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/storage"
+	"github.com/kkovaletp/photoview/api/utils"
+	"gorm.io/gorm"
+)
+
+// orphanGracePeriod protects recent files from being deleted as orphans,
+// as files are generated before the media URL referencing them is saved.
+const orphanGracePeriod = time.Hour
+
+// CheckConsistency reconciles the media cache with the media URLs in the database.
+// Files and directories not belonging to any media, like the ones of deleted albums or left over by interrupted processing,
+// are deleted. The media with missing files are passed to `reprocess` to generate them again, except the files evicted
+// because of the size limit of the cache, which are generated again when requested. Nothing is changed if `dryRun` is set.
+func CheckConsistency(db *gorm.DB, dryRun bool, reprocess func(media []*models.Media) error) (*models.MediaCacheCheckResult, error) {
+	var mediaAlbums []struct {
+		ID      int
+		AlbumID int
+	}
+	if err := db.Model(&models.Media{}).Select("id, album_id").Scan(&mediaAlbums).Error; err != nil {
+		return nil, err
+	}
+
+	albumOfMedia := make(map[int]int, len(mediaAlbums))
+	for _, media := range mediaAlbums {
+		albumOfMedia[media.ID] = media.AlbumID
+	}
+
+	urls, err := loadCachedURLs(db)
+	if err != nil {
+		return nil, err
+	}
+
+	store := storage.Default()
+	files, err := walkCache(store)
+	if err != nil {
+		return nil, err
+	}
+
+	result := models.MediaCacheCheckResult{}
+
+	collector := orphanCollector{store: store, dryRun: dryRun, result: &result, before: time.Now().Add(-orphanGracePeriod)}
+	collector.collect(files, albumOfMedia, urls)
+
+	storedKeys := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		storedKeys[file.key] = struct{}{}
+	}
+
+	maxSize := utils.MediaCacheMaxSize()
+	evictThumbnails := utils.EnvMediaCacheEvictThumbnails.GetBool()
+
+	missingMediaIDs := make(map[int]struct{})
+	for key, url := range urls {
+		albumID, found := albumOfMedia[key.mediaID]
+		if !found {
+			continue
+		}
+
+		if _, stored := storedKeys[storage.MediaKey(albumID, key.mediaID, key.name)]; stored {
+			continue
+		}
+
+		result.MissingFiles++
+
+		if maxSize > 0 && (evictThumbnails || !isThumbnail(url.Purpose)) {
+			continue
+		}
+		missingMediaIDs[key.mediaID] = struct{}{}
+	}
+
+	if len(missingMediaIDs) == 0 {
+		return &result, nil
+	}
+
+	ids := make([]int, 0, len(missingMediaIDs))
+	for id := range missingMediaIDs {
+		ids = append(ids, id)
+	}
+
+	var missingMedia []*models.Media
+	if err := db.Where("id IN (?)", ids).Find(&missingMedia).Error; err != nil {
+		return nil, err
+	}
+	result.QueuedMedia = len(missingMedia)
+
+	if !dryRun {
+		if err := reprocess(missingMedia); err != nil {
+			return nil, err
+		}
+	}
+
+	return &result, nil
+}
+
+// orphanCollector deletes the files of the media cache not belonging to any media.
+type orphanCollector struct {
+	store  storage.Storage
+	dryRun bool
+	result *models.MediaCacheCheckResult
+	// before is the time orphans must not have been modified since to be deleted
+	before time.Time
+}
+
+// orphan is a file, or all files under a prefix, not belonging to any media.
+type orphan struct {
+	isPrefix bool
+	files    int
+	size     int64
+	recent   bool
+}
+
+func (c *orphanCollector) collect(files []cachedFile, albumOfMedia map[int]int, urls map[cacheKey]cachedURL) {
+	orphans := make(map[string]*orphan)
+	keys := make([]string, 0)
+
+	for _, file := range files {
+		parts := strings.Split(file.key, "/")
+
+		albumID, err := strconv.Atoi(parts[0])
+		if len(parts) < 2 || err != nil {
+			// Not created by Photoview, so left alone
+			continue
+		}
+
+		var key string
+		isPrefix := false
+
+		mediaID, err := strconv.Atoi(parts[1])
+		if mediaAlbumID, found := albumOfMedia[mediaID]; len(parts) > 2 && (err != nil || !found || mediaAlbumID != albumID) {
+			// Directory of a deleted media, or not created by Photoview inside an album directory
+			key = path.Join(parts[0], parts[1])
+			isPrefix = true
+		} else if len(parts) == 2 {
+			key = file.key
+		} else if len(parts) > 3 {
+			key = path.Join(parts[0], parts[1], parts[2])
+			isPrefix = true
+		} else if _, found := urls[cacheKey{mediaID: mediaID, name: parts[2]}]; !found {
+			key = file.key
+		} else {
+			continue
+		}
+
+		unit, found := orphans[key]
+		if !found {
+			unit = &orphan{isPrefix: isPrefix}
+			orphans[key] = unit
+			keys = append(keys, key)
+		}
+
+		unit.files++
+		unit.size += file.size
+		if file.modTime.After(c.before) {
+			unit.recent = true
+		}
+	}
+
+	for _, key := range keys {
+		c.remove(key, orphans[key])
+	}
+}
+
+// remove deletes the orphaned file or prefix `key`, unless something in it was modified recently.
+func (c *orphanCollector) remove(key string, unit *orphan) {
+	if unit.recent {
+		return
+	}
+
+	if !c.dryRun {
+		var err error
+		if unit.isPrefix {
+			err = c.store.DeletePrefix(context.Background(), key)
+		} else {
+			err = c.store.Delete(context.Background(), key)
+		}
+
+		if err != nil {
+			log.Warn(nil, "Could not delete orphaned file from media cache", "key", key, "error", err)
+			return
+		}
+	}
+
+	c.result.DeletedFiles += unit.files
+	c.result.ReclaimedSize += unit.size
+}
@@ -0,0 +1,180 @@
+package media_cache
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/storage"
+	"github.com/kkovaletp/photoview/api/utils"
+	"gorm.io/gorm"
+)
+
+// evictionInterval is how often the size of the cache is checked against its limit.
+const evictionInterval = 10 * time.Minute
+
+// evictionTarget is the part of the limit the cache is reduced to once exceeded,
+// so files aren't evicted again right after the next few are generated.
+const evictionTarget = 0.9
+
+// evictionCounter keeps track of the evictions since the server started, reported in the stats of the cache.
+type evictionCounter struct {
+	mutex sync.Mutex
+	files int
+	size  int64
+	last  *time.Time
+}
+
+var evictions evictionCounter
+
+func (c *evictionCounter) add(files int, size int64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	c.files += files
+	c.size += size
+	c.last = &now
+}
+
+func (c *evictionCounter) read(stats *models.MediaCacheStats) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	stats.EvictedFiles = c.files
+	stats.EvictedSize = c.size
+	stats.LastEviction = c.last
+}
+
+// EvictLeastRecentlyUsed removes the least recently used files from the media cache, until it is smaller than `maxSize`.
+// Evicted files are generated again the next time they are requested. Thumbnails are only evicted if `evictThumbnails` is set,
+// and files not referenced by any media are never evicted. It returns the number and size of the evicted files.
+func EvictLeastRecentlyUsed(db *gorm.DB, maxSize int64, evictThumbnails bool) (int, int64, error) {
+	store := storage.Default()
+	files, err := walkCache(store)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var cacheSize int64
+	for _, file := range files {
+		cacheSize += file.size
+	}
+
+	if cacheSize <= maxSize {
+		return 0, 0, nil
+	}
+
+	urls, err := loadCachedURLs(db)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	type candidate struct {
+		file     cachedFile
+		lastUsed time.Time
+	}
+
+	candidates := make([]candidate, 0, len(files))
+	for _, file := range files {
+		url, found := urls[file.cacheKey()]
+		if !found || (isThumbnail(url.Purpose) && !evictThumbnails) {
+			continue
+		}
+		candidates = append(candidates, candidate{file: file, lastUsed: url.lastUsed()})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].lastUsed.Before(candidates[j].lastUsed)
+	})
+
+	target := int64(float64(maxSize) * evictionTarget)
+
+	var evictedFiles int
+	var evictedSize int64
+	for _, candidate := range candidates {
+		if cacheSize <= target {
+			break
+		}
+
+		if err := store.Delete(context.Background(), candidate.file.key); err != nil {
+			log.Warn(nil, "Could not evict file from media cache", "key", candidate.file.key, "error", err)
+			continue
+		}
+
+		cacheSize -= candidate.file.size
+		evictedFiles++
+		evictedSize += candidate.file.size
+	}
+
+	if evictedFiles > 0 {
+		evictions.add(evictedFiles, evictedSize)
+	}
+
+	if cacheSize > maxSize {
+		log.Warn(nil, "Media cache is still bigger than its limit, as the remaining files can't be evicted",
+			"size", cacheSize, "max_size", maxSize)
+	}
+
+	return evictedFiles, evictedSize, nil
+}
+
+var evictionDone chan struct{}
+var evictionLocker sync.Mutex
+
+// InitializeMediaCacheEviction starts to periodically evict files from the media cache,
+// if its size is limited by PHOTOVIEW_MEDIA_CACHE_MAX_SIZE_GB.
+func InitializeMediaCacheEviction(db *gorm.DB) {
+	maxSize := utils.MediaCacheMaxSize()
+	if maxSize == 0 {
+		return
+	}
+
+	evictThumbnails := utils.EnvMediaCacheEvictThumbnails.GetBool()
+
+	evictionLocker.Lock()
+	defer evictionLocker.Unlock()
+
+	if evictionDone != nil {
+		return
+	}
+
+	done := make(chan struct{})
+	evictionDone = done
+
+	log.Info(nil, "Media cache size limited", "max_size", maxSize, "evict_thumbnails", evictThumbnails)
+
+	go func() {
+		ticker := time.NewTicker(evictionInterval)
+		defer ticker.Stop()
+
+		for {
+			files, size, err := EvictLeastRecentlyUsed(db, maxSize, evictThumbnails)
+			if err != nil {
+				log.Error(nil, "Could not evict files from media cache", "error", err)
+			} else if files > 0 {
+				log.Info(nil, "Evicted least recently used files from media cache", "files", files, "size", size)
+			}
+
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// ShutdownMediaCacheEviction stops the periodic eviction of files from the media cache.
+func ShutdownMediaCacheEviction() {
+	evictionLocker.Lock()
+	defer evictionLocker.Unlock()
+
+	if evictionDone != nil {
+		close(evictionDone)
+		evictionDone = nil
+	}
+}
@@ -0,0 +1,172 @@
+package media_cache
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/storage"
+	"github.com/kkovaletp/photoview/api/utils"
+	"gorm.io/gorm"
+)
+
+// accessUpdateInterval is how often the last access of a cached file is written to the database,
+// so serving a file doesn't write to the database on every request.
+const accessUpdateInterval = time.Hour
+
+// RecordAccess marks the cached file of `mediaURL` as just used, so it is the last to be evicted from the cache.
+func RecordAccess(db *gorm.DB, mediaURL *models.MediaURL) {
+	now := time.Now()
+	if mediaURL.LastAccessedAt != nil && now.Sub(*mediaURL.LastAccessedAt) < accessUpdateInterval {
+		return
+	}
+
+	if err := db.Model(&models.MediaURL{}).Where("id = ?", mediaURL.ID).UpdateColumn("last_accessed_at", now).Error; err != nil {
+		log.Warn(nil, "Could not record access to cached media", "media_name", mediaURL.MediaName, "error", err)
+		return
+	}
+
+	mediaURL.LastAccessedAt = &now
+}
+
+// isThumbnail tells if the files of `purpose` are thumbnails, which are kept in the cache unless configured otherwise.
+func isThumbnail(purpose models.MediaPurpose) bool {
+	return purpose == models.PhotoThumbnail || purpose == models.VideoThumbnail
+}
+
+// cachedFile is a file found in the media cache, which is laid out as `<album id>/<media id>/<media name>`.
+type cachedFile struct {
+	key     string
+	mediaID int
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+func (f cachedFile) cacheKey() cacheKey {
+	return cacheKey{mediaID: f.mediaID, name: f.name}
+}
+
+type cacheKey struct {
+	mediaID int
+	name    string
+}
+
+// walkCache lists all files of the media cache kept in `store`.
+func walkCache(store storage.Storage) ([]cachedFile, error) {
+	objects, err := store.List(context.Background(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]cachedFile, 0, len(objects))
+	for _, object := range objects {
+		file := cachedFile{
+			key:     object.Key,
+			name:    path.Base(object.Key),
+			size:    object.Size,
+			modTime: object.ModTime,
+		}
+		if mediaID, err := strconv.Atoi(path.Base(path.Dir(object.Key))); err == nil {
+			file.mediaID = mediaID
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// cachedURL is the part of a media URL needed to manage its cached file.
+type cachedURL struct {
+	MediaID        int
+	MediaName      string
+	Purpose        models.MediaPurpose
+	CreatedAt      time.Time
+	LastAccessedAt *time.Time
+}
+
+// lastUsed returns when the file was last served, or created if it never was.
+func (u cachedURL) lastUsed() time.Time {
+	if u.LastAccessedAt != nil {
+		return *u.LastAccessedAt
+	}
+	return u.CreatedAt
+}
+
+// loadCachedURLs returns the media URLs of all files stored in the cache.
+func loadCachedURLs(db *gorm.DB) (map[cacheKey]cachedURL, error) {
+	var urls []cachedURL
+	if err := db.Model(&models.MediaURL{}).
+		Select("media_id, media_name, purpose, created_at, last_accessed_at").
+		Where("purpose <> ?", models.MediaOriginal).
+		Scan(&urls).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[cacheKey]cachedURL, len(urls))
+	for _, url := range urls {
+		result[cacheKey{mediaID: url.MediaID, name: url.MediaName}] = url
+	}
+
+	return result, nil
+}
+
+// ReadStats measures the disk usage of the media cache.
+func ReadStats(db *gorm.DB) (*models.MediaCacheStats, error) {
+	files, err := walkCache(storage.Default())
+	if err != nil {
+		return nil, err
+	}
+
+	urls, err := loadCachedURLs(db)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := models.MediaCacheStats{
+		FileCount:       len(files),
+		EvictThumbnails: utils.EnvMediaCacheEvictThumbnails.GetBool(),
+		Purposes:        make([]*models.MediaCachePurposeUsage, 0),
+	}
+
+	if maxSize := utils.MediaCacheMaxSize(); maxSize > 0 {
+		stats.MaxSize = &maxSize
+	}
+
+	purposes := make(map[string]*models.MediaCachePurposeUsage)
+	for _, file := range files {
+		stats.Size += file.size
+
+		// Files not referenced by any media are counted with an empty purpose
+		var purpose string
+		if url, found := urls[file.cacheKey()]; found {
+			purpose = string(url.Purpose)
+		}
+
+		usage, found := purposes[purpose]
+		if !found {
+			usage = &models.MediaCachePurposeUsage{}
+			if purpose != "" {
+				usage.Purpose = &purpose
+			}
+			purposes[purpose] = usage
+			stats.Purposes = append(stats.Purposes, usage)
+		}
+
+		usage.FileCount++
+		usage.Size += file.size
+	}
+
+	sort.Slice(stats.Purposes, func(i, j int) bool {
+		return stats.Purposes[i].Size > stats.Purposes[j].Size
+	})
+
+	evictions.read(&stats)
+
+	return &stats, nil
+}
@@ -0,0 +1,235 @@
+package media_cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/storage"
+	"github.com/kkovaletp/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMain(m *testing.M) {
+	test_utils.IntegrationTestRun(m)
+}
+
+// cacheFixture stores a file of `size` bytes in the cache of `media`, with a media URL last accessed at `lastAccess`.
+func cacheFixture(t *testing.T, db *gorm.DB, media *models.Media, name string, purpose models.MediaPurpose, size int, lastAccess *time.Time) string {
+	t.Helper()
+
+	cachePath, err := media.CachePath()
+	require.NoError(t, err)
+
+	filePath := filepath.Join(cachePath, name)
+	require.NoError(t, os.WriteFile(filePath, make([]byte, size), 0644))
+
+	mediaURL := models.MediaURL{
+		MediaID:        media.ID,
+		MediaName:      name,
+		Purpose:        purpose,
+		ContentType:    "image/jpeg",
+		FileSize:       int64(size),
+		LastAccessedAt: lastAccess,
+	}
+	require.NoError(t, db.Create(&mediaURL).Error)
+
+	return filePath
+}
+
+func createTestMedia(t *testing.T, db *gorm.DB) *models.Media {
+	t.Helper()
+
+	album := models.Album{Title: "album", Path: "/photos"}
+	require.NoError(t, db.Create(&album).Error)
+
+	media := models.Media{Title: "photo.cr2", Path: "/photos/photo.cr2", AlbumID: album.ID, Type: models.MediaTypePhoto}
+	require.NoError(t, db.Create(&media).Error)
+
+	return &media
+}
+
+func TestEvictLeastRecentlyUsed(t *testing.T) {
+	test_utils.FilesystemTest(t)
+	db := test_utils.DatabaseTest(t)
+
+	media := createTestMedia(t, db)
+	now := time.Now()
+	hoursAgo := func(hours int) *time.Time {
+		at := now.Add(-time.Duration(hours) * time.Hour)
+		return &at
+	}
+
+	oldest := cacheFixture(t, db, media, "highres_old.jpg", models.PhotoHighRes, 400, hoursAgo(30))
+	older := cacheFixture(t, db, media, "video_old.mp4", models.VideoWeb, 300, hoursAgo(20))
+	recent := cacheFixture(t, db, media, "highres_recent.jpg", models.PhotoHighRes, 200, hoursAgo(1))
+	thumbnail := cacheFixture(t, db, media, "thumbnail.jpg", models.PhotoThumbnail, 100, hoursAgo(40))
+
+	cachePath, err := media.CachePath()
+	require.NoError(t, err)
+	unreferenced := filepath.Join(cachePath, "unreferenced.jpg")
+	require.NoError(t, os.WriteFile(unreferenced, make([]byte, 100), 0644))
+
+	t.Run("UnderLimit", func(t *testing.T) {
+		files, size, err := EvictLeastRecentlyUsed(db, 1100, false)
+		require.NoError(t, err)
+		assert.Equal(t, 0, files)
+		assert.Equal(t, int64(0), size)
+	})
+
+	t.Run("KeepThumbnails", func(t *testing.T) {
+		// 1100 bytes are cached, reduced to 90% of the limit: 450 bytes
+		files, size, err := EvictLeastRecentlyUsed(db, 500, false)
+		require.NoError(t, err)
+		assert.Equal(t, 2, files)
+		assert.Equal(t, int64(700), size)
+
+		assert.NoFileExists(t, oldest)
+		assert.NoFileExists(t, older)
+		assert.FileExists(t, recent)
+		assert.FileExists(t, thumbnail)
+		assert.FileExists(t, unreferenced)
+	})
+
+	t.Run("EvictThumbnails", func(t *testing.T) {
+		files, size, err := EvictLeastRecentlyUsed(db, 350, true)
+		require.NoError(t, err)
+		assert.Equal(t, 1, files)
+		assert.Equal(t, int64(100), size)
+
+		assert.NoFileExists(t, thumbnail)
+		assert.FileExists(t, recent)
+		assert.FileExists(t, unreferenced)
+	})
+
+	t.Run("Stats", func(t *testing.T) {
+		stats, err := ReadStats(db)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(300), stats.Size)
+		assert.Equal(t, 2, stats.FileCount)
+		assert.Equal(t, 3, stats.EvictedFiles)
+		assert.Equal(t, int64(800), stats.EvictedSize)
+		assert.NotNil(t, stats.LastEviction)
+
+		require.Len(t, stats.Purposes, 2)
+		assert.Equal(t, string(models.PhotoHighRes), *stats.Purposes[0].Purpose)
+		assert.Equal(t, int64(200), stats.Purposes[0].Size)
+		assert.Nil(t, stats.Purposes[1].Purpose)
+		assert.Equal(t, 1, stats.Purposes[1].FileCount)
+	})
+}
+
+func TestRecordAccess(t *testing.T) {
+	test_utils.FilesystemTest(t)
+	db := test_utils.DatabaseTest(t)
+
+	media := createTestMedia(t, db)
+	cacheFixture(t, db, media, "highres.jpg", models.PhotoHighRes, 10, nil)
+
+	lastAccess := func() *time.Time {
+		var mediaURL models.MediaURL
+		require.NoError(t, db.Where("media_name = ?", "highres.jpg").First(&mediaURL).Error)
+		return mediaURL.LastAccessedAt
+	}
+
+	var mediaURL models.MediaURL
+	require.NoError(t, db.Where("media_name = ?", "highres.jpg").First(&mediaURL).Error)
+
+	RecordAccess(db, &mediaURL)
+	first := lastAccess()
+	require.NotNil(t, first)
+
+	// Accesses within the update interval aren't written
+	RecordAccess(db, &mediaURL)
+	assert.True(t, lastAccess().Equal(*first))
+
+	stale := first.Add(-2 * accessUpdateInterval)
+	mediaURL.LastAccessedAt = &stale
+	RecordAccess(db, &mediaURL)
+	assert.False(t, lastAccess().Equal(*first))
+}
+
+func TestWalkMissingCache(t *testing.T) {
+	files, err := walkCache(storage.NewLocal(filepath.Join(t.TempDir(), "missing")))
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestCheckConsistency(t *testing.T) {
+	test_utils.FilesystemTest(t)
+	db := test_utils.DatabaseTest(t)
+
+	media := createTestMedia(t, db)
+	cachePath, err := media.CachePath()
+	require.NoError(t, err)
+	cacheRoot := filepath.Dir(filepath.Dir(cachePath))
+
+	old := time.Now().Add(-2 * orphanGracePeriod)
+	writeFile := func(filePath string, size int, modTime time.Time) string {
+		t.Helper()
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		require.NoError(t, os.WriteFile(filePath, make([]byte, size), 0644))
+		require.NoError(t, os.Chtimes(filePath, modTime, modTime))
+		require.NoError(t, os.Chtimes(filepath.Dir(filePath), modTime, modTime))
+		return filePath
+	}
+
+	referenced := cacheFixture(t, db, media, "highres.jpg", models.PhotoHighRes, 10, nil)
+	hold := writeFile(filepath.Join(cachePath, "highres.jpg.hold"), 100, old)
+	fresh := writeFile(filepath.Join(cachePath, "generating.jpg"), 1000, time.Now())
+	deletedAlbum := writeFile(filepath.Join(cacheRoot, "999999", "1", "thumbnail.jpg"), 20, old)
+	require.NoError(t, os.Chtimes(filepath.Join(cacheRoot, "999999"), old, old))
+	deletedMedia := writeFile(filepath.Join(cacheRoot, filepath.Base(filepath.Dir(cachePath)), "888888", "thumbnail.jpg"), 30, old)
+	unmanaged := writeFile(filepath.Join(cacheRoot, "notes.txt"), 5, old)
+
+	evicted := cacheFixture(t, db, media, "highres_evicted.jpg", models.PhotoHighRes, 10, nil)
+	require.NoError(t, os.Remove(evicted))
+
+	var reprocessed []*models.Media
+	reprocess := func(media []*models.Media) error {
+		reprocessed = append(reprocessed, media...)
+		return nil
+	}
+
+	t.Run("DryRun", func(t *testing.T) {
+		result, err := CheckConsistency(db, true, reprocess)
+		require.NoError(t, err)
+
+		assert.Equal(t, models.MediaCacheCheckResult{DeletedFiles: 3, ReclaimedSize: 150, MissingFiles: 1, QueuedMedia: 1}, *result)
+		assert.Empty(t, reprocessed)
+		assert.FileExists(t, hold)
+		assert.FileExists(t, deletedAlbum)
+	})
+
+	t.Run("SizeLimit", func(t *testing.T) {
+		t.Setenv("PHOTOVIEW_MEDIA_CACHE_MAX_SIZE_GB", "1")
+
+		result, err := CheckConsistency(db, true, reprocess)
+		require.NoError(t, err)
+
+		// Evicted files are generated again on request only
+		assert.Equal(t, 1, result.MissingFiles)
+		assert.Equal(t, 0, result.QueuedMedia)
+	})
+
+	t.Run("Collect", func(t *testing.T) {
+		result, err := CheckConsistency(db, false, reprocess)
+		require.NoError(t, err)
+
+		assert.Equal(t, models.MediaCacheCheckResult{DeletedFiles: 3, ReclaimedSize: 150, MissingFiles: 1, QueuedMedia: 1}, *result)
+		require.Len(t, reprocessed, 1)
+		assert.Equal(t, media.ID, reprocessed[0].ID)
+
+		assert.FileExists(t, referenced)
+		assert.FileExists(t, fresh)
+		assert.FileExists(t, unmanaged)
+		assert.NoFileExists(t, hold)
+		assert.NoDirExists(t, filepath.Join(cacheRoot, "999999"))
+		assert.NoDirExists(t, filepath.Dir(deletedMedia))
+	})
+}
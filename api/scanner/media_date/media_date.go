@@ -0,0 +1,211 @@
+// Package media_date finds the date a media was shot, for media without a date in their metadata.
+//
+// The sources of dates are tried in the order configured with PHOTOVIEW_DATE_SOURCES, by default
+// `exif,filename,folder,modtime`. The modification time of the file is always the last resort.
+package media_date
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/utils"
+)
+
+// DefaultSources is the order of the date sources if PHOTOVIEW_DATE_SOURCES is not set
+var DefaultSources = []models.MediaDateSource{
+	models.MediaDateSourceExif,
+	models.MediaDateSourceFilename,
+	models.MediaDateSourceFolder,
+	models.MediaDateSourceModTime,
+}
+
+// sourceNames are the names of the date sources in PHOTOVIEW_DATE_SOURCES
+var sourceNames = map[string]models.MediaDateSource{
+	"exif":     models.MediaDateSourceExif,
+	"filename": models.MediaDateSourceFilename,
+	"folder":   models.MediaDateSourceFolder,
+	"modtime":  models.MediaDateSourceModTime,
+	"mtime":    models.MediaDateSourceModTime,
+}
+
+// unknownSources are the unknown names in PHOTOVIEW_DATE_SOURCES which were logged already
+var unknownSources sync.Map
+
+// Sources returns the configured order of the date sources, ending with the modification time of the file.
+func Sources() []models.MediaDateSource {
+	value := utils.EnvDateSources.GetValue()
+	if strings.TrimSpace(value) == "" {
+		return DefaultSources
+	}
+
+	sources := make([]models.MediaDateSource, 0, len(DefaultSources))
+	for _, name := range strings.Split(value, ",") {
+		source, ok := sourceNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			if _, logged := unknownSources.LoadOrStore(name, true); !logged {
+				log.Warn(context.Background(), "ignoring unknown date source", utils.EnvDateSources.GetName(), name)
+			}
+			continue
+		}
+
+		if !slices.Contains(sources, source) {
+			sources = append(sources, source)
+		}
+	}
+
+	if !slices.Contains(sources, models.MediaDateSourceModTime) {
+		sources = append(sources, models.MediaDateSourceModTime)
+	}
+
+	return sources
+}
+
+// Prefers reports whether the date from `source` should replace the date of a media found in `current`,
+// as it comes first in the configured order. Any source is preferred over an unknown current source.
+func Prefers(source models.MediaDateSource, current *models.MediaDateSource) bool {
+	sources := Sources()
+
+	sourceIndex := slices.Index(sources, source)
+	if sourceIndex < 0 {
+		return false
+	}
+
+	if current == nil {
+		return true
+	}
+
+	currentIndex := slices.Index(sources, *current)
+	return currentIndex < 0 || sourceIndex < currentIndex
+}
+
+// Infer returns the date of the media at `mediaPath` from the first configured source which has one,
+// except for EXIF which is read later by the scanner. The modification time `modTime` is the last resort.
+func Infer(mediaPath string, modTime time.Time) (time.Time, models.MediaDateSource) {
+	for _, source := range Sources() {
+		var date time.Time
+		var ok bool
+
+		switch source {
+		case models.MediaDateSourceFilename:
+			date, ok = FromFilename(path.Base(mediaPath))
+		case models.MediaDateSourceFolder:
+			date, ok = FromFolders(path.Dir(mediaPath))
+		}
+
+		if ok {
+			return date, source
+		}
+	}
+
+	return modTime, models.MediaDateSourceModTime
+}
+
+var (
+	// filenameDateTime matches a date and time like `IMG_20200131_142233`, `PXL_20200131_142233123`
+	// or `Screenshot_2021-05-03-14-22-33`
+	filenameDateTime = regexp.MustCompile(
+		`(?:^|\D)(\d{4})[-_.]?(\d{2})[-_.]?(\d{2})[-_. T]?(\d{2})[-_.:]?(\d{2})[-_.:]?(\d{2})`)
+	// filenameDate matches a date like `IMG-20200131-WA0001` or `Screenshot_2021-05-03`
+	filenameDate = regexp.MustCompile(`(?:^|\D)(\d{4})[-_.]?(\d{2})[-_.]?(\d{2})(?:\D|$)`)
+	// folderDate matches a date at the start of a folder name, like `2019-07-14 Rome`, `2019-07 Italy` or `2019`
+	folderDate = regexp.MustCompile(`^(\d{4})(?:[-_. ](\d{2})(?:[-_. ](\d{2}))?)?(?:\D|$)`)
+)
+
+// FromFilename returns the date in the file name `name`, like `IMG_20200131_142233.jpg`.
+// The date is in local time like EXIF dates, so it is stored as UTC.
+func FromFilename(name string) (time.Time, bool) {
+	date, _, ok := fromFilename(name)
+	return date, ok
+}
+
+// fromFilename returns the date in the file name `name`, and whether the name includes the time of day.
+func fromFilename(name string) (date time.Time, hasTime bool, ok bool) {
+	name = strings.TrimSuffix(name, path.Ext(name))
+
+	if match := filenameDateTime.FindStringSubmatch(name); match != nil {
+		if date, ok := parseDate(match[1:]); ok {
+			return date, true, true
+		}
+	}
+
+	if match := filenameDate.FindStringSubmatch(name); match != nil {
+		date, ok := parseDate(match[1:])
+		return date, false, ok
+	}
+
+	return time.Time{}, false, false
+}
+
+// HasTime reports whether the date of the media at `mediaPath` found in the source `source` includes the time of day,
+// which is midnight for the dates of folders and of file names without a time.
+// Modification times of files are no time the media was shot, so they don't count.
+func HasTime(mediaPath string, source models.MediaDateSource) bool {
+	switch source {
+	case models.MediaDateSourceExif:
+		return true
+	case models.MediaDateSourceFilename:
+		_, hasTime, ok := fromFilename(path.Base(mediaPath))
+		return ok && hasTime
+	}
+
+	return false
+}
+
+// FromFolders returns the date in the name of the folder `dirPath` or the closest folder above it
+// having a date, like `2019-07 Italy`. Missing months and days are the first of the year or month.
+func FromFolders(dirPath string) (time.Time, bool) {
+	for dirPath != "." && dirPath != "/" && dirPath != "" {
+		if match := folderDate.FindStringSubmatch(path.Base(dirPath)); match != nil {
+			parts := match[1:]
+			for i, part := range parts {
+				if part == "" {
+					parts[i] = "01"
+				}
+			}
+
+			if date, ok := parseDate(parts); ok {
+				return date, true
+			}
+		}
+
+		dirPath = path.Dir(dirPath)
+	}
+
+	return time.Time{}, false
+}
+
+// parseDate builds a date from its year, month, day and optionally hour, minute and second,
+// returning false if it is no valid date of a photo.
+func parseDate(parts []string) (time.Time, bool) {
+	values := make([]int, 6)
+	for i, part := range parts {
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return time.Time{}, false
+		}
+		values[i] = value
+	}
+
+	year, month, day, hour, minute, second := values[0], values[1], values[2], values[3], values[4], values[5]
+	if year < 1900 || year > time.Now().Year()+1 {
+		return time.Time{}, false
+	}
+
+	date := time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC)
+
+	// time.Date normalizes values out of range, like the 31st of April to the 1st of May
+	if date.Month() != time.Month(month) || date.Day() != day || date.Hour() != hour ||
+		date.Minute() != minute || date.Second() != second {
+		return time.Time{}, false
+	}
+
+	return date, true
+}
@@ -0,0 +1,112 @@
+package media_date
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromFilename(t *testing.T) {
+	tests := []struct {
+		name string
+		want time.Time
+	}{
+		{"IMG_20200131_142233.jpg", time.Date(2020, 1, 31, 14, 22, 33, 0, time.UTC)},
+		{"PXL_20200131_142233123.jpg", time.Date(2020, 1, 31, 14, 22, 33, 0, time.UTC)},
+		{"Screenshot_2021-05-03-14-22-33.png", time.Date(2021, 5, 3, 14, 22, 33, 0, time.UTC)},
+		{"2021-05-03 14.22.33.jpg", time.Date(2021, 5, 3, 14, 22, 33, 0, time.UTC)},
+		{"Screenshot_2021-05-03.png", time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC)},
+		{"IMG-20200131-WA0001.jpg", time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{"IMG_20200131_992233.jpg", time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			date, ok := FromFilename(tc.name)
+			assert.True(t, ok)
+			assert.Equal(t, tc.want, date)
+		})
+	}
+
+	for _, name := range []string{"IMG_0001.jpg", "holiday.jpg", "12345678.jpg", "IMG_20200231_120000.jpg", "DSC_18000101.jpg"} {
+		t.Run(name, func(t *testing.T) {
+			_, ok := FromFilename(name)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestFromFolders(t *testing.T) {
+	tests := []struct {
+		dir  string
+		want time.Time
+		ok   bool
+	}{
+		{"/photos/2019-07 Italy", time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC), true},
+		{"/photos/2019-07-14 Rome", time.Date(2019, 7, 14, 0, 0, 0, 0, time.UTC), true},
+		{"/photos/2019/Italy", time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{"/photos/2019-07.zip/Rome", time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC), true},
+		{"/photos/1080p videos", time.Time{}, false},
+		{"/photos/Italy 2019", time.Time{}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.dir, func(t *testing.T) {
+			date, ok := FromFolders(tc.dir)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, date)
+		})
+	}
+}
+
+func TestInfer(t *testing.T) {
+	modTime := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	date, source := Infer("/photos/2019-07 Italy/IMG-20190712-WA0001.jpg", modTime)
+	assert.Equal(t, models.MediaDateSourceFilename, source)
+	assert.Equal(t, time.Date(2019, 7, 12, 0, 0, 0, 0, time.UTC), date)
+
+	date, source = Infer("/photos/2019-07 Italy/holiday.jpg", modTime)
+	assert.Equal(t, models.MediaDateSourceFolder, source)
+	assert.Equal(t, time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC), date)
+
+	date, source = Infer("/photos/holiday.jpg", modTime)
+	assert.Equal(t, models.MediaDateSourceModTime, source)
+	assert.Equal(t, modTime, date)
+
+	t.Setenv(utils.EnvDateSources.GetName(), "folder, exif, unknown")
+	date, source = Infer("/photos/2019-07 Italy/IMG-20190712-WA0001.jpg", modTime)
+	assert.Equal(t, models.MediaDateSourceFolder, source)
+	assert.Equal(t, time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC), date)
+
+	date, source = Infer("/photos/IMG-20190712-WA0001.jpg", modTime)
+	assert.Equal(t, models.MediaDateSourceModTime, source, "modification time is the last resort")
+	assert.Equal(t, modTime, date)
+}
+
+func TestHasTime(t *testing.T) {
+	assert.True(t, HasTime("/photos/holiday.jpg", models.MediaDateSourceExif))
+	assert.True(t, HasTime("/photos/IMG_20200131_142233.jpg", models.MediaDateSourceFilename))
+	assert.False(t, HasTime("/photos/IMG-20200131-WA0001.jpg", models.MediaDateSourceFilename))
+	assert.False(t, HasTime("/photos/2019-07 Italy/holiday.jpg", models.MediaDateSourceFolder))
+	assert.False(t, HasTime("/photos/holiday.jpg", models.MediaDateSourceModTime))
+}
+
+func TestPrefers(t *testing.T) {
+	filename := models.MediaDateSourceFilename
+	modTime := models.MediaDateSourceModTime
+
+	assert.True(t, Prefers(models.MediaDateSourceExif, &filename))
+	assert.True(t, Prefers(models.MediaDateSourceExif, nil))
+
+	t.Setenv(utils.EnvDateSources.GetName(), "filename,exif")
+	assert.False(t, Prefers(models.MediaDateSourceExif, &filename))
+	assert.True(t, Prefers(models.MediaDateSourceExif, &modTime))
+	assert.Equal(t, []models.MediaDateSource{filename, models.MediaDateSourceExif, modTime}, Sources())
+
+	t.Setenv(utils.EnvDateSources.GetName(), "filename,mtime")
+	assert.False(t, Prefers(models.MediaDateSourceExif, &modTime), "EXIF dates are not used if left out")
+}
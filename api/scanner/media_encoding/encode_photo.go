@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"image"
+	"os"
+	"path/filepath"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/scanner/externaltools/exif"
 	"github.com/kkovaletp/photoview/api/utils"
 	"github.com/kkovaletp/photoview/api/scanner/media_encoding/executable_worker"
 	"github.com/kkovaletp/photoview/api/scanner/media_type"
@@ -52,7 +56,7 @@ func (d *Dimension) ThumbnailScale() Dimension {
 
 // GetPhotoDimensions returns the dimension of the image `imagePath`.
 func GetPhotoDimensions(imagePath string) (Dimension, error) {
-	w, h, err := executable_worker.Magick.IdentifyDimension(imagePath)
+	w, h, err := executable_worker.Image.IdentifyDimension(imagePath)
 	if err != nil {
 		return Dimension{}, fmt.Errorf("identify dimension %q error: %w", imagePath, err)
 	}
@@ -66,7 +70,7 @@ func GetPhotoDimensions(imagePath string) (Dimension, error) {
 // EncodeThumbnail encodes a thumbnail of `inputPath`, and store it as `outputPath`.
 // It returns the dimension of the thumbnail. The thumbnail will be not bigger than 1024x1024.
 func EncodeThumbnail(db *gorm.DB, inputPath string, outputPath string) (Dimension, error) {
-	w, h, err := executable_worker.Magick.IdentifyDimension(inputPath)
+	w, h, err := executable_worker.Image.IdentifyDimension(inputPath)
 	if err != nil {
 		return Dimension{}, fmt.Errorf("can't generate thumbnail of file %q: %w", inputPath, err)
 	}
@@ -77,11 +81,11 @@ func EncodeThumbnail(db *gorm.DB, inputPath string, outputPath string) (Dimensio
 	}
 	thumbnail := origin.ThumbnailScale()
 
-	if err := executable_worker.Magick.GenerateThumbnail(inputPath, outputPath, uint(thumbnail.Width), uint(thumbnail.Height)); err != nil {
+	if err := executable_worker.Image.GenerateThumbnail(inputPath, outputPath, uint(thumbnail.Width), uint(thumbnail.Height)); err != nil {
 		return Dimension{}, fmt.Errorf("can't generate thumbnail of file %q: %w", inputPath, err)
 	}
 
-	w, h, err = executable_worker.Magick.IdentifyDimension(outputPath)
+	w, h, err = executable_worker.Image.IdentifyDimension(outputPath)
 	if err != nil {
 		return Dimension{}, fmt.Errorf("can't generate thumbnail of file %q: %w", inputPath, err)
 	}
@@ -97,13 +101,20 @@ func EncodeThumbnail(db *gorm.DB, inputPath string, outputPath string) (Dimensio
 type EncodeMediaData struct {
 	Media           *models.Media
 	CounterpartPath *string
-	_photoImage     image.Image
-	_contentType    media_type.MediaType
-	_videoMetadata  *ffprobe.ProbeData
+	MotionVideoPath *string
+	// Edit holds the non-destructive edits applied to the high-res version of a photo, if any
+	Edit *models.MediaEdit
+	// EditsChanged is set when a change of the edits of a photo dropped its thumbnail, and moved its faces along
+	EditsChanged bool
+	// RenderSideCar forces RAW photos to be decoded, as their embedded preview doesn't reflect the edits of a sidecar file
+	RenderSideCar  bool
+	_photoImage    image.Image
+	_contentType   media_type.MediaType
+	_videoMetadata *ffprobe.ProbeData
 }
 
 func NewEncodeMediaData(media *models.Media) EncodeMediaData {
-	fileType := media_type.GetMediaType(media.Path)
+	fileType := media_type.GetMediaType(media.FilePath())
 
 	return EncodeMediaData{
 		Media:        media,
@@ -117,7 +128,7 @@ func (img *EncodeMediaData) ContentType() (media_type.MediaType, error) {
 		return img._contentType, nil
 	}
 
-	imgType := media_type.GetMediaType(img.Media.Path)
+	imgType := media_type.GetMediaType(img.Media.FilePath())
 	if imgType == media_type.TypeUnknown {
 		return imgType, fmt.Errorf("unknown type of %q", img.Media.Path)
 	}
@@ -136,14 +147,48 @@ func (img *EncodeMediaData) EncodeHighRes(outputPath string) error {
 		return errors.New("could not convert photo as file format is not supported")
 	}
 
+	// Edited photos always get a high-res version, as the original is never modified
+	if contentType.IsImage() && img.Edit != nil {
+		imgPath := img.Media.FilePath()
+		if img.CounterpartPath != nil {
+			imgPath = *img.CounterpartPath
+		}
+
+		edit := executable_worker.ImageEdit{
+			Rotation:       img.Edit.Rotation,
+			FlipHorizontal: img.Edit.FlipHorizontal,
+			FlipVertical:   img.Edit.FlipVertical,
+			CropX:          img.Edit.CropX,
+			CropY:          img.Edit.CropY,
+			CropWidth:      img.Edit.CropWidth,
+			CropHeight:     img.Edit.CropHeight,
+			Exposure:       img.Edit.Exposure,
+			Contrast:       img.Edit.Contrast,
+		}
+
+		if err := executable_worker.Image.EditImage(imgPath, outputPath, edit, 70); err != nil {
+			return fmt.Errorf("failed to apply edits to photo %q: %w", imgPath, err)
+		}
+
+		return nil
+	}
+
 	// Use magick if there is no counterpart JPEG file to use instead
 	if contentType.IsImage() && !contentType.IsWebCompatible() {
-		imgPath := img.Media.Path
+		imgPath := img.Media.FilePath()
 		if img.CounterpartPath != nil {
 			imgPath = *img.CounterpartPath
+		} else if img.useEmbeddedPreview() {
+			encoded, err := img.encodeEmbeddedPreview(outputPath)
+			if err != nil {
+				log.Warn(nil, "Could not use the embedded preview of RAW photo, decoding it instead", "path", imgPath, "error", err)
+			}
+			if encoded {
+				return nil
+			}
 		}
 
-		err := executable_worker.Magick.EncodeJpeg(imgPath, outputPath, 70)
+		err := executable_worker.Image.EncodeJpeg(imgPath, outputPath, 70)
 		if err != nil {
 			return fmt.Errorf("failed to convert RAW photo %q to JPEG: %w", imgPath, err)
 		}
@@ -152,6 +197,56 @@ func (img *EncodeMediaData) EncodeHighRes(outputPath string) error {
 	return nil
 }
 
+// minEmbeddedPreviewScale is the part of the size of a RAW photo its embedded preview must have to be used as high-res,
+// smaller previews are only meant for the display of the camera.
+const minEmbeddedPreviewScale = 0.5
+
+// useEmbeddedPreview tells if the high-res version of the RAW photo may be made from its embedded JPEG preview.
+func (img *EncodeMediaData) useEmbeddedPreview() bool {
+	return utils.EnvRawEmbeddedPreview.GetBool() && !img.RenderSideCar && img.Media.SideCarPath == nil
+}
+
+// encodeEmbeddedPreview encodes the high-res version of a RAW photo from the JPEG preview embedded in it,
+// which is much faster than decoding the RAW data. It returns false if the photo has no preview big enough.
+func (img *EncodeMediaData) encodeEmbeddedPreview(outputPath string) (bool, error) {
+	rawWidth, rawHeight, err := exif.ImageSize(img.Media.FilePath())
+	if err != nil {
+		return false, err
+	}
+
+	if rawWidth <= 0 || rawHeight <= 0 {
+		return false, nil
+	}
+
+	tmpDir, err := os.MkdirTemp("", "photoview-preview-")
+	if err != nil {
+		return false, fmt.Errorf("create temporary directory for embedded preview: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	previewPath := filepath.Join(tmpDir, "preview.jpg")
+	saved, err := exif.SaveEmbeddedPreview(img.Media.FilePath(), previewPath)
+	if err != nil || !saved {
+		return false, err
+	}
+
+	width, height, err := executable_worker.Image.IdentifyDimension(previewPath)
+	if err != nil {
+		return false, err
+	}
+
+	if float64(max(width, height)) < minEmbeddedPreviewScale*float64(max(rawWidth, rawHeight)) {
+		return false, nil
+	}
+
+	// SaveEmbeddedPreview wrote the orientation of the RAW file onto the preview, which is applied when encoding it
+	if err := executable_worker.Image.EncodeJpeg(previewPath, outputPath, 70); err != nil {
+		return false, fmt.Errorf("failed to convert embedded preview of RAW photo %q to JPEG: %w", img.Media.Path, err)
+	}
+
+	return true, nil
+}
+
 func (enc *EncodeMediaData) VideoMetadata() (*ffprobe.ProbeData, error) {
 
 	if enc._videoMetadata != nil {
@@ -160,7 +255,7 @@ func (enc *EncodeMediaData) VideoMetadata() (*ffprobe.ProbeData, error) {
 
 	ctx, cancelFn := context.WithTimeout(context.Background(), utils.MediaProbeTimeout())
 	defer cancelFn()
-	data, err := ffprobe.ProbeURL(ctx, enc.Media.Path)
+	data, err := ffprobe.ProbeURL(ctx, enc.Media.FilePath())
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not read video metadata (%s)", enc.Media.Title)
 	}
@@ -0,0 +1,38 @@
+package media_encoding
+
+import (
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/utils"
+)
+
+func TestUseEmbeddedPreview(t *testing.T) {
+	sideCar := "photo.cr2.xmp"
+
+	tests := []struct {
+		name          string
+		enabled       string
+		sideCarPath   *string
+		renderSideCar bool
+		want          bool
+	}{
+		{"Disabled", "", nil, false, false},
+		{"Enabled", "true", nil, false, true},
+		{"SideCar", "true", &sideCar, false, false},
+		{"RenderSideCar", "true", nil, true, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(utils.EnvRawEmbeddedPreview.GetName(), tc.enabled)
+
+			data := NewEncodeMediaData(&models.Media{Path: "photo.cr2", SideCarPath: tc.sideCarPath})
+			data.RenderSideCar = tc.renderSideCar
+
+			if got := data.useEmbeddedPreview(); got != tc.want {
+				t.Errorf("useEmbeddedPreview() = %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -7,15 +7,30 @@ import (
 	"strings"
 
 	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/utils"
 	"gopkg.in/vansante/go-ffprobe.v2"
 )
 
 var ErrNoDependency = errors.New("dependency not found")
 var ErrDisabledFunction = errors.New("function disabled")
 
+// newImageWorkers returns the pool of image worker processes, or the image backend itself
+// if photos are to be processed in the server process.
+func newImageWorkers() ImageBackend {
+	if workers := utils.ImageWorkers(); workers > 0 {
+		pool, err := newImageWorkerPool(workers, utils.ImageWorkerTimeout())
+		if err == nil {
+			return pool
+		}
+		log.Error(nil, "Could not start image workers, processing photos in the server process", "error", err)
+	}
+
+	return newImageBackend()
+}
+
 // Initialize Initializes all workers. It returns a function to terminate workers, which should be called before the program closing.
 func Initialize() func() {
-	Magick = newMagickWand()
+	Image = newImageWorkers()
 	Ffmpeg = newFfmpegCli()
 
 	if err := SetFfprobePath(); err != nil {
@@ -23,12 +38,13 @@ func Initialize() func() {
 	}
 
 	return func() {
-		Magick.Terminate()
-		Magick = nil
+		Image.Terminate()
+		Image = nil
 	}
 }
 
-var Magick *MagickWand = nil
+// Image is the backend used to decode and encode photos, selected by PHOTOVIEW_IMAGE_BACKEND.
+var Image ImageBackend = nil
 var Ffmpeg *FfmpegCli = nil
 
 type ExecutableWorker interface {
@@ -2,7 +2,10 @@ package executable_worker
 
 import (
 	"fmt"
+	"math"
+	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 
 	"github.com/kkovaletp/photoview/api/log"
@@ -12,6 +15,11 @@ import (
 
 const defaultCodec = "h264"
 
+const thumbnailScaleFilter = "scale='min(1024,iw)':'min(1024,ih)':force_original_aspect_ratio=decrease:force_divisible_by=2"
+
+// blackFrameThreshold is the percentage of black pixels from which a frame is considered black.
+const blackFrameThreshold = "90"
+
 var hwAccToCodec = map[string]string{
 	"qsv":   defaultCodec + "_qsv",
 	"vaapi": defaultCodec + "_vaapi",
@@ -95,20 +103,61 @@ func (cli *FfmpegCli) EncodeMp4(inputPath string, outputPath string) error {
 	return nil
 }
 
+// EncodeVideoThumbnail grabs a frame at 25% of the duration of the video. Black frames, like fades between scenes,
+// are skipped in favour of the next frame with content. If no such frame exists, the frame at the offset is used.
 func (cli *FfmpegCli) EncodeVideoThumbnail(inputPath string, outputPath string, probeData *ffprobe.ProbeData) error {
 	if cli.err != nil {
 		return fmt.Errorf("encoding video thumbnail %q error: ffmpeg: %w", inputPath, cli.err)
 	}
 
-	thumbnailOffsetSeconds := fmt.Sprintf("%.f", probeData.Format.DurationSeconds*0.25)
+	thumbnailOffsetSeconds := math.RoundToEven(probeData.Format.DurationSeconds * 0.25)
 
 	args := []string{
-		"-ss", thumbnailOffsetSeconds, // grab frame at time offset
+		"-ss", formatSeekSeconds(thumbnailOffsetSeconds), // grab frame at time offset
+		"-i",
+		inputPath,
+		"-vframes", "1", // output one frame
+		"-an",                          // disable audio
+		"-vf", "blackframe=amount=0," + // tag every frame with its percentage of black pixels
+			"metadata=mode=select:key=lavfi.blackframe.pblack:value=" + blackFrameThreshold + ":function=less," +
+			thumbnailScaleFilter,
+		outputPath,
+	}
+
+	cmd := exec.Command(cli.path, args...)
+
+	if err := cmd.Run(); err != nil {
+		log.Warn(nil, "Skipping black frames of video thumbnail failed, using the frame at the offset", "input", inputPath, "error", err)
+	} else if _, err := os.Stat(outputPath); err == nil {
+		return nil
+	}
+
+	return cli.EncodeVideoThumbnailAt(inputPath, outputPath, thumbnailOffsetSeconds)
+}
+
+// formatSeekSeconds formats a time offset of a video for ffmpeg, in whole seconds if possible
+// and with millisecond precision otherwise.
+func formatSeekSeconds(seconds float64) string {
+	if seconds == math.Trunc(seconds) {
+		return fmt.Sprintf("%.f", seconds)
+	}
+
+	return strconv.FormatFloat(seconds, 'f', 3, 64)
+}
+
+// EncodeVideoThumbnailAt grabs the frame of the video at `offsetSeconds` as is.
+func (cli *FfmpegCli) EncodeVideoThumbnailAt(inputPath string, outputPath string, offsetSeconds float64) error {
+	if cli.err != nil {
+		return fmt.Errorf("encoding video thumbnail %q error: ffmpeg: %w", inputPath, cli.err)
+	}
+
+	args := []string{
+		"-ss", formatSeekSeconds(offsetSeconds), // grab frame at time offset
 		"-i",
 		inputPath,
 		"-vframes", "1", // output one frame
 		"-an", // disable audio
-		"-vf", "scale='min(1024,iw)':'min(1024,ih)':force_original_aspect_ratio=decrease:force_divisible_by=2",
+		"-vf", thumbnailScaleFilter,
 		outputPath,
 	}
 
@@ -120,3 +169,56 @@ func (cli *FfmpegCli) EncodeVideoThumbnail(inputPath string, outputPath string,
 
 	return nil
 }
+
+// EncodeVideoSprite takes a frame every `intervalSeconds` and tiles them into a single JPEG of `columns` by `rows` frames.
+// Every frame is scaled to `tileWidth` pixels wide, keeping the aspect ratio.
+func (cli *FfmpegCli) EncodeVideoSprite(inputPath string, outputPath string, intervalSeconds float64, tileWidth, columns, rows int) error {
+	if cli.err != nil {
+		return fmt.Errorf("encoding video sprite %q error: ffmpeg: %w", inputPath, cli.err)
+	}
+
+	args := []string{
+		"-i",
+		inputPath,
+		"-an", // disable audio
+		"-vf", fmt.Sprintf("fps=1/%g,scale=%d:-2,tile=%dx%d", intervalSeconds, tileWidth, columns, rows),
+		"-frames:v", "1", // the whole sprite sheet is a single frame
+		"-q:v", "5",
+		outputPath,
+	}
+
+	cmd := exec.Command(cli.path, args...)
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("encoding video sprite with %q %v error: %w", cli.path, args, err)
+	}
+
+	return nil
+}
+
+// EncodeVideoPreview encodes a silent, low resolution mp4 clip of `lengthSeconds` starting at `startSeconds` of the video.
+func (cli *FfmpegCli) EncodeVideoPreview(inputPath string, outputPath string, startSeconds, lengthSeconds float64) error {
+	if cli.err != nil {
+		return fmt.Errorf("encoding video preview %q error: ffmpeg: %w", inputPath, cli.err)
+	}
+
+	args := []string{
+		"-ss", fmt.Sprintf("%g", startSeconds),
+		"-t", fmt.Sprintf("%g", lengthSeconds),
+		"-i",
+		inputPath,
+		"-an", // disable audio
+		"-vcodec", cli.videoCodec,
+		"-vf", "scale='min(480,iw)':-2",
+		"-movflags", "+faststart",
+		outputPath,
+	}
+
+	cmd := exec.Command(cli.path, args...)
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("encoding video preview with %q %v error: %w", cli.path, args, err)
+	}
+
+	return nil
+}
@@ -29,6 +29,18 @@ func TestFfmpegNotExist(t *testing.T) {
 	if got, want := Ffmpeg.EncodeVideoThumbnail("input", "output", nil), ErrNoDependency; !errors.Is(got, want) {
 		t.Errorf("Ffmpge.EncodeMp4() = %v, want: %v", got, want)
 	}
+
+	if got, want := Ffmpeg.EncodeVideoThumbnailAt("input", "output", 1), ErrNoDependency; !errors.Is(got, want) {
+		t.Errorf("Ffmpge.EncodeVideoThumbnailAt() = %v, want: %v", got, want)
+	}
+
+	if got, want := Ffmpeg.EncodeVideoSprite("input", "output", 10, 160, 10, 1), ErrNoDependency; !errors.Is(got, want) {
+		t.Errorf("Ffmpge.EncodeVideoSprite() = %v, want: %v", got, want)
+	}
+
+	if got, want := Ffmpeg.EncodeVideoPreview("input", "output", 2.5, 4), ErrNoDependency; !errors.Is(got, want) {
+		t.Errorf("Ffmpge.EncodeVideoPreview() = %v, want: %v", got, want)
+	}
 }
 
 func TestFfmpegVersionFail(t *testing.T) {
@@ -52,6 +64,18 @@ func TestFfmpegVersionFail(t *testing.T) {
 	if got, want := Ffmpeg.EncodeVideoThumbnail("input", "output", nil), ErrNoDependency; !errors.Is(got, want) {
 		t.Errorf("Ffmpge.EncodeMp4() = %v, want: %v", got, want)
 	}
+
+	if got, want := Ffmpeg.EncodeVideoThumbnailAt("input", "output", 1), ErrNoDependency; !errors.Is(got, want) {
+		t.Errorf("Ffmpge.EncodeVideoThumbnailAt() = %v, want: %v", got, want)
+	}
+
+	if got, want := Ffmpeg.EncodeVideoSprite("input", "output", 10, 160, 10, 1), ErrNoDependency; !errors.Is(got, want) {
+		t.Errorf("Ffmpge.EncodeVideoSprite() = %v, want: %v", got, want)
+	}
+
+	if got, want := Ffmpeg.EncodeVideoPreview("input", "output", 2.5, 4), ErrNoDependency; !errors.Is(got, want) {
+		t.Errorf("Ffmpge.EncodeVideoPreview() = %v, want: %v", got, want)
+	}
 }
 
 func TestFfmpegIgnore(t *testing.T) {
@@ -75,6 +99,18 @@ func TestFfmpegIgnore(t *testing.T) {
 	if got, want := Ffmpeg.EncodeVideoThumbnail("input", "output", nil), ErrDisabledFunction; !errors.Is(got, want) {
 		t.Errorf("Ffmpge.EncodeMp4() = %v, want: %v", got, want)
 	}
+
+	if got, want := Ffmpeg.EncodeVideoThumbnailAt("input", "output", 1), ErrDisabledFunction; !errors.Is(got, want) {
+		t.Errorf("Ffmpge.EncodeVideoThumbnailAt() = %v, want: %v", got, want)
+	}
+
+	if got, want := Ffmpeg.EncodeVideoSprite("input", "output", 10, 160, 10, 1), ErrDisabledFunction; !errors.Is(got, want) {
+		t.Errorf("Ffmpge.EncodeVideoSprite() = %v, want: %v", got, want)
+	}
+
+	if got, want := Ffmpeg.EncodeVideoPreview("input", "output", 2.5, 4), ErrDisabledFunction; !errors.Is(got, want) {
+		t.Errorf("Ffmpge.EncodeVideoPreview() = %v, want: %v", got, want)
+	}
 }
 
 func TestFfmpeg(t *testing.T) {
@@ -128,6 +164,56 @@ func TestFfmpeg(t *testing.T) {
 			t.Fatalf("Ffmpeg.EncodeVideoThumbnail(...) = %v, should be nil.", err)
 		}
 	})
+
+	t.Run("EncodeVideoThumbnailAtFailed", func(t *testing.T) {
+		t.Setenv("FAIL_WITH", "expect failure")
+
+		err := Ffmpeg.EncodeVideoThumbnailAt("input", "output", 7.4)
+		if err == nil {
+			t.Fatalf("Ffmpeg.EncodeVideoThumbnailAt(...) = nil, should be an error.")
+		}
+		if got, want := err.Error(), `^encoding video thumbnail with ".*/test_data/mock_bin/ffmpeg" \[-ss 7\.400 -i input .* output\] error: .*$`; !regexp.MustCompile(want).MatchString(got) {
+			t.Errorf("Ffmpeg.EncodeVideoThumbnailAt(...) = %q, should be as reg pattern %q", got, want)
+		}
+	})
+
+	t.Run("EncodeVideoSpriteFailed", func(t *testing.T) {
+		t.Setenv("FAIL_WITH", "expect failure")
+
+		err := Ffmpeg.EncodeVideoSprite("input", "output", 10, 160, 10, 2)
+		if err == nil {
+			t.Fatalf("Ffmpeg.EncodeVideoSprite(...) = nil, should be an error.")
+		}
+		if got, want := err.Error(), `^encoding video sprite with ".*/test_data/mock_bin/ffmpeg" \[-i input -an -vf fps=1/10,scale=160:-2,tile=10x2 .* output\] error: .*$`; !regexp.MustCompile(want).MatchString(got) {
+			t.Errorf("Ffmpeg.EncodeVideoSprite(...) = %q, should be as reg pattern %q", got, want)
+		}
+	})
+
+	t.Run("EncodeVideoSpriteSucceeded", func(t *testing.T) {
+		err := Ffmpeg.EncodeVideoSprite("input", "output", 10, 160, 10, 2)
+		if err != nil {
+			t.Fatalf("Ffmpeg.EncodeVideoSprite(...) = %v, should be nil.", err)
+		}
+	})
+
+	t.Run("EncodeVideoPreviewFailed", func(t *testing.T) {
+		t.Setenv("FAIL_WITH", "expect failure")
+
+		err := Ffmpeg.EncodeVideoPreview("input", "output", 2.5, 4)
+		if err == nil {
+			t.Fatalf("Ffmpeg.EncodeVideoPreview(...) = nil, should be an error.")
+		}
+		if got, want := err.Error(), `^encoding video preview with ".*/test_data/mock_bin/ffmpeg" \[-ss 2.5 -t 4 -i input -an -vcodec h264 .* output\] error: .*$`; !regexp.MustCompile(want).MatchString(got) {
+			t.Errorf("Ffmpeg.EncodeVideoPreview(...) = %q, should be as reg pattern %q", got, want)
+		}
+	})
+
+	t.Run("EncodeVideoPreviewSucceeded", func(t *testing.T) {
+		err := Ffmpeg.EncodeVideoPreview("input", "output", 2.5, 4)
+		if err != nil {
+			t.Fatalf("Ffmpeg.EncodeVideoPreview(...) = %v, should be nil.", err)
+		}
+	})
 }
 
 func TestFfmpegWithHWAcc(t *testing.T) {
@@ -0,0 +1,69 @@
+package executable_worker
+
+import (
+	"strings"
+
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/utils"
+)
+
+// ImageBackend decodes and encodes the photos handled by the scanner.
+// Every backend must pass the conformance suite in image_backend_test.go.
+type ImageBackend interface {
+	// IsInstalled tells if the backend can be used.
+	IsInstalled() bool
+	// Terminate releases the resources held by the backend.
+	Terminate()
+	// IdentifyDimension returns the dimension of `inputPath`, once oriented following its EXIF orientation.
+	IdentifyDimension(inputPath string) (width, height uint, err error)
+	// GenerateThumbnail encodes `inputPath` as a JPEG of at most `width`x`height` pixels, keeping its aspect ratio.
+	GenerateThumbnail(inputPath string, outputPath string, width, height uint) error
+	// EncodeJpeg encodes `inputPath` as a JPEG in full resolution.
+	EncodeJpeg(inputPath string, outputPath string, jpegQuality uint) error
+	// EditImage applies `edit` to `inputPath` and encodes the result as a JPEG.
+	EditImage(inputPath string, outputPath string, edit ImageEdit, jpegQuality uint) error
+}
+
+// ImageEdit describes the edits EditImage applies, in order: rotation, flips, crop and the adjustments.
+type ImageEdit struct {
+	// Rotation in degrees clockwise
+	Rotation       int
+	FlipHorizontal bool
+	FlipVertical   bool
+	// The crop rectangle, relative (0 to 1) to the rotated and flipped image
+	CropX, CropY, CropWidth, CropHeight float64
+	// Exposure compensation in stops
+	Exposure float64
+	// Contrast adjustment, between -100 and 100
+	Contrast float64
+}
+
+// HasCrop tells if the edit crops the image.
+func (e ImageEdit) HasCrop() bool {
+	return e.CropX > 0 || e.CropY > 0 || e.CropWidth < 1 || e.CropHeight < 1
+}
+
+const (
+	imageBackendMagick = "magick"
+	imageBackendVips   = "vips"
+)
+
+// newImageBackend returns the image backend selected by the PHOTOVIEW_IMAGE_BACKEND environment variable.
+// It falls back to MagickWand if the selected backend is unknown or not installed.
+func newImageBackend() ImageBackend {
+	name := strings.ToLower(utils.EnvImageBackend.GetValue())
+
+	switch name {
+	case "", imageBackendMagick:
+	case imageBackendVips:
+		vips := newVipsCli()
+		if vips.IsInstalled() {
+			return vips
+		}
+		log.Error(nil, "Image backend not available, falling back to magick", utils.EnvImageBackend.GetName(), name)
+	default:
+		log.Error(nil, "Unknown image backend, falling back to magick", utils.EnvImageBackend.GetName(), name)
+	}
+
+	return newMagickWand()
+}
@@ -0,0 +1,288 @@
+package executable_worker
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var (
+	testRed  = color.RGBA{R: 220, G: 20, B: 20, A: 255}
+	testBlue = color.RGBA{R: 20, G: 20, B: 220, A: 255}
+)
+
+// writeTestJpeg writes a 64x32 JPEG, red on the left half and blue on the right half.
+// If `orientation` is not 0, the image is tagged with this EXIF orientation.
+func writeTestJpeg(t *testing.T, path string, orientation byte) {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, 64, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 64; x++ {
+			if x < 32 {
+				img.Set(x, y, testRed)
+			} else {
+				img.Set(x, y, testBlue)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	if orientation != 0 {
+		// An APP1 segment holding a big-endian TIFF header and a single IFD with the orientation tag
+		exif := []byte("Exif\x00\x00MM\x00\x2a\x00\x00\x00\x08" +
+			"\x00\x01" + "\x01\x12\x00\x03\x00\x00\x00\x01\x00" + string([]byte{orientation}) + "\x00\x00" +
+			"\x00\x00\x00\x00")
+		segment := append([]byte{0xFF, 0xE1, 0, byte(len(exif) + 2)}, exif...)
+		data = append(append(append([]byte{}, data[:2]...), segment...), data[2:]...)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestJpeg(t *testing.T, path string) image.Image {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	img, err := jpeg.Decode(file)
+	if err != nil {
+		t.Fatalf("output %q is not a valid JPEG: %v", path, err)
+	}
+
+	return img
+}
+
+func assertSize(t *testing.T, img image.Image, width, height int) {
+	t.Helper()
+
+	if got := img.Bounds().Size(); got.X != width || got.Y != height {
+		t.Errorf("image size = %dx%d, want %dx%d", got.X, got.Y, width, height)
+	}
+}
+
+// assertColorAt checks that the pixel at the relative position (`x`, `y`) is closer to `want` than to `other`.
+func assertColorAt(t *testing.T, img image.Image, x, y float64, want, other color.RGBA) {
+	t.Helper()
+
+	bounds := img.Bounds()
+	px := bounds.Min.X + int(x*float64(bounds.Dx()))
+	py := bounds.Min.Y + int(y*float64(bounds.Dy()))
+
+	r, g, b, _ := img.At(px, py).RGBA()
+	distance := func(c color.RGBA) int {
+		dr := int(r>>8) - int(c.R)
+		dg := int(g>>8) - int(c.G)
+		db := int(b>>8) - int(c.B)
+		return dr*dr + dg*dg + db*db
+	}
+
+	if distance(want) >= distance(other) {
+		t.Errorf("color at (%g, %g) = (%d, %d, %d), want close to %v", x, y, r>>8, g>>8, b>>8, want)
+	}
+}
+
+func meanBrightness(img image.Image) float64 {
+	var sum float64
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			sum += float64(r+g+b) / 3
+		}
+	}
+	return sum / float64(bounds.Dx()*bounds.Dy())
+}
+
+// testImageBackendConformance is the behaviour every ImageBackend must have.
+func testImageBackendConformance(t *testing.T, backend ImageBackend) {
+	dir := t.TempDir()
+
+	plainPath := filepath.Join(dir, "plain.jpg")
+	writeTestJpeg(t, plainPath, 0)
+
+	// Orientation 6 is displayed rotated by 90 degrees clockwise
+	orientedPath := filepath.Join(dir, "oriented.jpg")
+	writeTestJpeg(t, orientedPath, 6)
+
+	noEdit := ImageEdit{CropWidth: 1, CropHeight: 1}
+
+	t.Run("IdentifyDimension", func(t *testing.T) {
+		width, height, err := backend.IdentifyDimension(plainPath)
+		if err != nil {
+			t.Fatalf("IdentifyDimension() returns error: %v", err)
+		}
+		if width != 64 || height != 32 {
+			t.Errorf("IdentifyDimension() = %dx%d, want 64x32", width, height)
+		}
+	})
+
+	t.Run("IdentifyDimensionOriented", func(t *testing.T) {
+		width, height, err := backend.IdentifyDimension(orientedPath)
+		if err != nil {
+			t.Fatalf("IdentifyDimension() returns error: %v", err)
+		}
+		if width != 32 || height != 64 {
+			t.Errorf("IdentifyDimension() = %dx%d, want 32x64", width, height)
+		}
+	})
+
+	t.Run("IdentifyDimensionMissingFile", func(t *testing.T) {
+		if _, _, err := backend.IdentifyDimension(filepath.Join(dir, "missing.jpg")); err == nil {
+			t.Error("IdentifyDimension() of a missing file returns nil, want an error")
+		}
+	})
+
+	t.Run("GenerateThumbnail", func(t *testing.T) {
+		outputPath := filepath.Join(dir, "thumbnail.jpg")
+		if err := backend.GenerateThumbnail(plainPath, outputPath, 32, 16); err != nil {
+			t.Fatalf("GenerateThumbnail() returns error: %v", err)
+		}
+
+		img := readTestJpeg(t, outputPath)
+		assertSize(t, img, 32, 16)
+		assertColorAt(t, img, 0.25, 0.5, testRed, testBlue)
+		assertColorAt(t, img, 0.75, 0.5, testBlue, testRed)
+	})
+
+	t.Run("GenerateThumbnailOriented", func(t *testing.T) {
+		outputPath := filepath.Join(dir, "thumbnail_oriented.jpg")
+		if err := backend.GenerateThumbnail(orientedPath, outputPath, 16, 32); err != nil {
+			t.Fatalf("GenerateThumbnail() returns error: %v", err)
+		}
+
+		img := readTestJpeg(t, outputPath)
+		assertSize(t, img, 16, 32)
+		assertColorAt(t, img, 0.5, 0.25, testRed, testBlue)
+	})
+
+	t.Run("EncodeJpeg", func(t *testing.T) {
+		outputPath := filepath.Join(dir, "encoded.jpg")
+		if err := backend.EncodeJpeg(orientedPath, outputPath, 70); err != nil {
+			t.Fatalf("EncodeJpeg() returns error: %v", err)
+		}
+
+		img := readTestJpeg(t, outputPath)
+		assertSize(t, img, 32, 64)
+		assertColorAt(t, img, 0.5, 0.25, testRed, testBlue)
+		assertColorAt(t, img, 0.5, 0.75, testBlue, testRed)
+	})
+
+	t.Run("EncodeJpegMissingFile", func(t *testing.T) {
+		if err := backend.EncodeJpeg(filepath.Join(dir, "missing.jpg"), filepath.Join(dir, "missing_out.jpg"), 70); err == nil {
+			t.Error("EncodeJpeg() of a missing file returns nil, want an error")
+		}
+	})
+
+	editTests := []struct {
+		name   string
+		edit   func(edit *ImageEdit)
+		width  int
+		height int
+		// The colors expected at the top left and bottom right corners
+		topLeft, bottomRight color.RGBA
+	}{
+		{"NoEdit", func(edit *ImageEdit) {}, 64, 32, testRed, testBlue},
+		{"Rotate90", func(edit *ImageEdit) { edit.Rotation = 90 }, 32, 64, testRed, testBlue},
+		{"Rotate180", func(edit *ImageEdit) { edit.Rotation = 180 }, 64, 32, testBlue, testRed},
+		{"Rotate270", func(edit *ImageEdit) { edit.Rotation = 270 }, 32, 64, testBlue, testRed},
+		{"FlipHorizontal", func(edit *ImageEdit) { edit.FlipHorizontal = true }, 64, 32, testBlue, testRed},
+		{"FlipVertical", func(edit *ImageEdit) { edit.FlipVertical = true }, 64, 32, testRed, testBlue},
+		{"CropRightHalf", func(edit *ImageEdit) {
+			edit.CropX, edit.CropWidth = 0.5, 0.5
+		}, 32, 32, testBlue, testBlue},
+		{"RotateThenCrop", func(edit *ImageEdit) {
+			edit.Rotation = 90
+			edit.CropHeight = 0.5
+		}, 32, 32, testRed, testRed},
+	}
+
+	for _, tc := range editTests {
+		t.Run("EditImage"+tc.name, func(t *testing.T) {
+			edit := noEdit
+			tc.edit(&edit)
+
+			outputPath := filepath.Join(dir, "edit_"+tc.name+".jpg")
+			if err := backend.EditImage(plainPath, outputPath, edit, 90); err != nil {
+				t.Fatalf("EditImage() returns error: %v", err)
+			}
+
+			img := readTestJpeg(t, outputPath)
+			assertSize(t, img, tc.width, tc.height)
+
+			other := func(c color.RGBA) color.RGBA {
+				if c == testRed {
+					return testBlue
+				}
+				return testRed
+			}
+			assertColorAt(t, img, 0.1, 0.1, tc.topLeft, other(tc.topLeft))
+			assertColorAt(t, img, 0.9, 0.9, tc.bottomRight, other(tc.bottomRight))
+		})
+	}
+
+	t.Run("EditImageOriented", func(t *testing.T) {
+		outputPath := filepath.Join(dir, "edit_oriented.jpg")
+		if err := backend.EditImage(orientedPath, outputPath, noEdit, 90); err != nil {
+			t.Fatalf("EditImage() returns error: %v", err)
+		}
+
+		img := readTestJpeg(t, outputPath)
+		assertSize(t, img, 32, 64)
+		assertColorAt(t, img, 0.5, 0.25, testRed, testBlue)
+	})
+
+	t.Run("EditImageAdjustments", func(t *testing.T) {
+		reference := filepath.Join(dir, "adjust_reference.jpg")
+		if err := backend.EditImage(plainPath, reference, noEdit, 90); err != nil {
+			t.Fatalf("EditImage() returns error: %v", err)
+		}
+		referenceBrightness := meanBrightness(readTestJpeg(t, reference))
+
+		darker := noEdit
+		darker.Exposure = -1
+
+		outputPath := filepath.Join(dir, "adjust_darker.jpg")
+		if err := backend.EditImage(plainPath, outputPath, darker, 90); err != nil {
+			t.Fatalf("EditImage() returns error: %v", err)
+		}
+
+		img := readTestJpeg(t, outputPath)
+		assertSize(t, img, 64, 32)
+		if got := meanBrightness(img); got >= referenceBrightness {
+			t.Errorf("brightness with exposure -1 = %g, want less than %g", got, referenceBrightness)
+		}
+	})
+}
+
+func TestMagickWandConformance(t *testing.T) {
+	backend := newMagickWand()
+	defer backend.Terminate()
+
+	testImageBackendConformance(t, backend)
+}
+
+func TestVipsCliConformance(t *testing.T) {
+	backend := newVipsCli()
+	if !backend.IsInstalled() {
+		t.Skip("vips is not installed")
+	}
+	defer backend.Terminate()
+
+	testImageBackendConformance(t, backend)
+}
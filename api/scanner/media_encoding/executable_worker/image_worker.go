@@ -0,0 +1,123 @@
+package executable_worker
+
+import (
+	"fmt"
+	"io"
+	"net/rpc"
+	"os"
+
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/utils"
+)
+
+// ImageWorkerCommand is the argument starting the Photoview executable as an image worker process.
+const ImageWorkerCommand = "image-worker"
+
+// File descriptors of the pipes an image worker process receives requests and sends responses through.
+// They are passed as extra files, so output of the image libraries on stdout can't corrupt the RPC stream.
+const (
+	imageWorkerRequestsFd  = 3
+	imageWorkerResponsesFd = 4
+)
+
+// IsImageWorkerProcess tells if the executable was started as an image worker process by an ImageWorkerPool.
+func IsImageWorkerProcess() bool {
+	return len(os.Args) > 1 && os.Args[1] == ImageWorkerCommand
+}
+
+// RunImageWorkerProcess serves the requests of the parent process with the image backend selected by the environment.
+// It returns once the parent closes the connection.
+func RunImageWorkerProcess() {
+	if limit := utils.ImageWorkerMemoryLimit(); limit > 0 {
+		if err := setProcessMemoryLimit(limit); err != nil {
+			log.Error(nil, "Could not limit memory of image worker", "error", err)
+		}
+	}
+
+	backend := newImageBackend()
+	defer backend.Terminate()
+
+	requests := os.NewFile(imageWorkerRequestsFd, "image-worker-requests")
+	responses := os.NewFile(imageWorkerResponsesFd, "image-worker-responses")
+
+	if err := serveImageWorker(requests, responses, backend); err != nil {
+		log.Error(nil, "Image worker stopped", "error", err)
+	}
+}
+
+func serveImageWorker(requests io.ReadCloser, responses io.WriteCloser, backend ImageBackend) error {
+	server := rpc.NewServer()
+	if err := server.RegisterName(imageWorkerServiceName, &ImageWorkerService{backend: backend}); err != nil {
+		return fmt.Errorf("register image worker service: %w", err)
+	}
+
+	server.ServeConn(&pipeConn{ReadCloser: requests, WriteCloser: responses})
+	return nil
+}
+
+// pipeConn joins the two pipes to a process into a single connection.
+type pipeConn struct {
+	io.ReadCloser
+	io.WriteCloser
+}
+
+func (c *pipeConn) Close() error {
+	readErr := c.ReadCloser.Close()
+	if err := c.WriteCloser.Close(); err != nil {
+		return err
+	}
+	return readErr
+}
+
+const imageWorkerServiceName = "ImageWorker"
+
+// ImageWorkerService exposes an ImageBackend over RPC, in an image worker process.
+type ImageWorkerService struct {
+	backend ImageBackend
+}
+
+type IdentifyDimensionArgs struct {
+	InputPath string
+}
+
+type IdentifyDimensionReply struct {
+	Width, Height uint
+}
+
+type GenerateThumbnailArgs struct {
+	InputPath, OutputPath string
+	Width, Height         uint
+}
+
+type EncodeJpegArgs struct {
+	InputPath, OutputPath string
+	JpegQuality           uint
+}
+
+type EditImageArgs struct {
+	InputPath, OutputPath string
+	Edit                  ImageEdit
+	JpegQuality           uint
+}
+
+func (s *ImageWorkerService) IdentifyDimension(args IdentifyDimensionArgs, reply *IdentifyDimensionReply) error {
+	width, height, err := s.backend.IdentifyDimension(args.InputPath)
+	if err != nil {
+		return err
+	}
+
+	reply.Width, reply.Height = width, height
+	return nil
+}
+
+func (s *ImageWorkerService) GenerateThumbnail(args GenerateThumbnailArgs, reply *bool) error {
+	return s.backend.GenerateThumbnail(args.InputPath, args.OutputPath, args.Width, args.Height)
+}
+
+func (s *ImageWorkerService) EncodeJpeg(args EncodeJpegArgs, reply *bool) error {
+	return s.backend.EncodeJpeg(args.InputPath, args.OutputPath, args.JpegQuality)
+}
+
+func (s *ImageWorkerService) EditImage(args EditImageArgs, reply *bool) error {
+	return s.backend.EditImage(args.InputPath, args.OutputPath, args.Edit, args.JpegQuality)
+}
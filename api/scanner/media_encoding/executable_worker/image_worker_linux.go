@@ -0,0 +1,24 @@
+//go:build linux
+
+package executable_worker
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// setProcessMemoryLimit caps the address space of the current process to `limit` bytes.
+// The limit is inherited by the processes it starts, like the vips command line tools.
+func setProcessMemoryLimit(limit int64) error {
+	rlimit := syscall.Rlimit{Cur: uint64(limit), Max: uint64(limit)}
+	if err := syscall.Setrlimit(syscall.RLIMIT_AS, &rlimit); err != nil {
+		return fmt.Errorf("set address space limit to %d bytes: %w", limit, err)
+	}
+
+	return nil
+}
+
+// imageWorkerSysProcAttr makes image worker processes get killed when the server exits.
+func imageWorkerSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Pdeathsig: syscall.SIGKILL}
+}
@@ -0,0 +1,19 @@
+//go:build !linux
+
+package executable_worker
+
+import (
+	"syscall"
+
+	"github.com/kkovaletp/photoview/api/log"
+)
+
+// setProcessMemoryLimit is only supported on Linux, the limit is ignored elsewhere.
+func setProcessMemoryLimit(limit int64) error {
+	log.Warn(nil, "Memory limit of image workers is only supported on Linux")
+	return nil
+}
+
+func imageWorkerSysProcAttr() *syscall.SysProcAttr {
+	return nil
+}
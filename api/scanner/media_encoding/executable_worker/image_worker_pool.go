@@ -0,0 +1,312 @@
+package executable_worker
+
+import (
+	"errors"
+	"fmt"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/log"
+)
+
+// ImageWorkerPool is the image backend running the image libraries in supervised child processes,
+// so a file crashing or exhausting them only fails the processing of this file, instead of taking down the server.
+//
+// Workers are started on demand, up to the size of the pool. A worker which crashes, or takes longer than
+// the timeout on a job, is killed and replaced by a new one for the next job.
+type ImageWorkerPool struct {
+	timeout time.Duration
+	// command returns the command starting a worker process
+	command func() *exec.Cmd
+
+	slots chan struct{}
+	idle  chan *imageWorker
+
+	mutex      sync.Mutex
+	workers    map[*imageWorker]struct{}
+	terminated bool
+}
+
+// ErrImageWorkerCrashed is returned when the worker process died while processing a job.
+var ErrImageWorkerCrashed = errors.New("image worker crashed")
+
+// ErrImageWorkerTimeout is returned when a job took longer than the timeout of the pool.
+var ErrImageWorkerTimeout = errors.New("image worker timed out")
+
+// ErrImageWorkerPoolTerminated is returned for jobs submitted after the pool was terminated.
+var ErrImageWorkerPoolTerminated = errors.New("image worker pool terminated")
+
+func newImageWorkerPool(size int, timeout time.Duration) (*ImageWorkerPool, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("find executable to start image workers: %w", err)
+	}
+
+	log.Info(nil, "Processing photos in image worker processes", "workers", size, "timeout", timeout)
+
+	return newImageWorkerPoolWithCommand(size, timeout, func() *exec.Cmd {
+		return exec.Command(executable, ImageWorkerCommand)
+	}), nil
+}
+
+func newImageWorkerPoolWithCommand(size int, timeout time.Duration, command func() *exec.Cmd) *ImageWorkerPool {
+	return &ImageWorkerPool{
+		timeout: timeout,
+		command: command,
+		slots:   make(chan struct{}, size),
+		idle:    make(chan *imageWorker, size),
+		workers: make(map[*imageWorker]struct{}),
+	}
+}
+
+func (p *ImageWorkerPool) IsInstalled() bool {
+	return p != nil
+}
+
+// Terminate stops all worker processes.
+func (p *ImageWorkerPool) Terminate() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.terminated = true
+	for worker := range p.workers {
+		worker.kill()
+	}
+	p.workers = make(map[*imageWorker]struct{})
+}
+
+func (p *ImageWorkerPool) IdentifyDimension(inputPath string) (width, height uint, reterr error) {
+	var reply IdentifyDimensionReply
+	if err := p.call("IdentifyDimension", inputPath, IdentifyDimensionArgs{InputPath: inputPath}, &reply); err != nil {
+		return 0, 0, err
+	}
+
+	return reply.Width, reply.Height, nil
+}
+
+func (p *ImageWorkerPool) GenerateThumbnail(inputPath string, outputPath string, width, height uint) error {
+	args := GenerateThumbnailArgs{
+		InputPath:  inputPath,
+		OutputPath: outputPath,
+		Width:      width,
+		Height:     height,
+	}
+
+	return p.call("GenerateThumbnail", inputPath, args, new(bool))
+}
+
+func (p *ImageWorkerPool) EncodeJpeg(inputPath string, outputPath string, jpegQuality uint) error {
+	args := EncodeJpegArgs{
+		InputPath:   inputPath,
+		OutputPath:  outputPath,
+		JpegQuality: jpegQuality,
+	}
+
+	return p.call("EncodeJpeg", inputPath, args, new(bool))
+}
+
+func (p *ImageWorkerPool) EditImage(inputPath string, outputPath string, edit ImageEdit, jpegQuality uint) error {
+	args := EditImageArgs{
+		InputPath:   inputPath,
+		OutputPath:  outputPath,
+		Edit:        edit,
+		JpegQuality: jpegQuality,
+	}
+
+	return p.call("EditImage", inputPath, args, new(bool))
+}
+
+// call runs `method` in a worker, waiting for a free one if all are busy.
+func (p *ImageWorkerPool) call(method string, inputPath string, args any, reply any) error {
+	worker, err := p.acquire()
+	if err != nil {
+		return fmt.Errorf("%s of %q error: %w", method, inputPath, err)
+	}
+
+	call := worker.client.Go(imageWorkerServiceName+"."+method, args, reply, make(chan *rpc.Call, 1))
+
+	timer := time.NewTimer(p.timeout)
+	defer timer.Stop()
+
+	select {
+	case <-call.Done:
+		var serverError rpc.ServerError
+		if call.Error == nil || errors.As(call.Error, &serverError) {
+			p.release(worker)
+			if call.Error != nil {
+				return fmt.Errorf("%s of %q error: %s", method, inputPath, call.Error.Error())
+			}
+			return nil
+		}
+
+		exitErr := worker.waitExit(time.Second)
+		p.discard(worker)
+		return fmt.Errorf("%s of %q error: %w (%v)", method, inputPath, ErrImageWorkerCrashed, exitErr)
+
+	case <-timer.C:
+		p.discard(worker)
+		return fmt.Errorf("%s of %q error: %w after %s", method, inputPath, ErrImageWorkerTimeout, p.timeout)
+	}
+}
+
+// acquire returns an idle worker, or starts a new one if none is idle.
+func (p *ImageWorkerPool) acquire() (*imageWorker, error) {
+	p.slots <- struct{}{}
+
+	if p.isTerminated() {
+		<-p.slots
+		return nil, ErrImageWorkerPoolTerminated
+	}
+
+	for {
+		select {
+		case worker := <-p.idle:
+			if worker.alive() {
+				return worker, nil
+			}
+			// The worker died while idle, a new one takes its place
+			p.forget(worker)
+			continue
+		default:
+		}
+
+		worker, err := p.start()
+		if err != nil {
+			<-p.slots
+			return nil, err
+		}
+
+		return worker, nil
+	}
+}
+
+// release makes a worker available for the next job.
+func (p *ImageWorkerPool) release(worker *imageWorker) {
+	p.idle <- worker
+	<-p.slots
+}
+
+// discard kills a worker, so a new one is started for the next job.
+func (p *ImageWorkerPool) discard(worker *imageWorker) {
+	worker.kill()
+	p.forget(worker)
+	<-p.slots
+}
+
+func (p *ImageWorkerPool) isTerminated() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.terminated
+}
+
+func (p *ImageWorkerPool) forget(worker *imageWorker) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	delete(p.workers, worker)
+}
+
+func (p *ImageWorkerPool) start() (*imageWorker, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.terminated {
+		return nil, ErrImageWorkerPoolTerminated
+	}
+
+	worker, err := startImageWorker(p.command())
+	if err != nil {
+		return nil, err
+	}
+
+	p.workers[worker] = struct{}{}
+	return worker, nil
+}
+
+// imageWorker is a running worker process.
+type imageWorker struct {
+	cmd    *exec.Cmd
+	client *rpc.Client
+	exited chan struct{}
+	// waitErr is the result of waiting for the process, set once exited is closed
+	waitErr error
+}
+
+func startImageWorker(cmd *exec.Cmd) (*imageWorker, error) {
+	requestsReader, requestsWriter, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("create pipe to image worker: %w", err)
+	}
+
+	responsesReader, responsesWriter, err := os.Pipe()
+	if err != nil {
+		requestsReader.Close()
+		requestsWriter.Close()
+		return nil, fmt.Errorf("create pipe from image worker: %w", err)
+	}
+
+	cmd.ExtraFiles = []*os.File{requestsReader, responsesWriter}
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	cmd.SysProcAttr = imageWorkerSysProcAttr()
+
+	err = cmd.Start()
+
+	// The worker process holds its own copies of these ends
+	requestsReader.Close()
+	responsesWriter.Close()
+
+	if err != nil {
+		requestsWriter.Close()
+		responsesReader.Close()
+		return nil, fmt.Errorf("start image worker: %w", err)
+	}
+
+	worker := &imageWorker{
+		cmd:    cmd,
+		client: rpc.NewClient(&pipeConn{ReadCloser: responsesReader, WriteCloser: requestsWriter}),
+		exited: make(chan struct{}),
+	}
+
+	go func() {
+		worker.waitErr = cmd.Wait()
+		close(worker.exited)
+	}()
+
+	return worker, nil
+}
+
+func (w *imageWorker) alive() bool {
+	select {
+	case <-w.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+// waitExit returns how the process exited, waiting at most `timeout` for it.
+func (w *imageWorker) waitExit(timeout time.Duration) error {
+	select {
+	case <-w.exited:
+		if w.waitErr == nil {
+			return errors.New("exited")
+		}
+		return w.waitErr
+	case <-time.After(timeout):
+		return errors.New("connection lost")
+	}
+}
+
+func (w *imageWorker) kill() {
+	if w.alive() {
+		if err := w.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			log.Warn(nil, "Could not kill image worker", "pid", w.cmd.Process.Pid, "error", err)
+		}
+	}
+	w.client.Close()
+}
@@ -0,0 +1,198 @@
+package executable_worker
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const imageWorkerHelperEnv = "PHOTOVIEW_TEST_IMAGE_WORKER"
+
+// crashingImageBackend misbehaves following the input path, to test the supervision of image workers.
+type crashingImageBackend struct{}
+
+func (crashingImageBackend) IsInstalled() bool { return true }
+
+func (crashingImageBackend) Terminate() {}
+
+func (crashingImageBackend) IdentifyDimension(inputPath string) (uint, uint, error) {
+	switch inputPath {
+	case "crash":
+		os.Exit(2)
+	case "panic":
+		panic("corrupt image")
+	case "hang":
+		time.Sleep(time.Hour)
+	case "error":
+		return 0, 0, errors.New("unsupported image")
+	case "pid":
+		return uint(os.Getpid()), 0, nil
+	}
+
+	return 64, 32, nil
+}
+
+func (crashingImageBackend) GenerateThumbnail(inputPath string, outputPath string, width, height uint) error {
+	return nil
+}
+
+func (crashingImageBackend) EncodeJpeg(inputPath string, outputPath string, jpegQuality uint) error {
+	return nil
+}
+
+func (crashingImageBackend) EditImage(inputPath string, outputPath string, edit ImageEdit, jpegQuality uint) error {
+	return nil
+}
+
+// TestImageWorkerHelperProcess is not a real test, it is the image worker process started by the tests of the pool.
+func TestImageWorkerHelperProcess(t *testing.T) {
+	backendName := os.Getenv(imageWorkerHelperEnv)
+	if backendName == "" {
+		return
+	}
+
+	var backend ImageBackend = crashingImageBackend{}
+	if backendName == imageBackendMagick {
+		backend = newMagickWand()
+	}
+
+	requests := os.NewFile(imageWorkerRequestsFd, "requests")
+	responses := os.NewFile(imageWorkerResponsesFd, "responses")
+	if err := serveImageWorker(requests, responses, backend); err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func newTestImageWorkerPool(t *testing.T, size int, timeout time.Duration, backendName string) *ImageWorkerPool {
+	pool := newImageWorkerPoolWithCommand(size, timeout, func() *exec.Cmd {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestImageWorkerHelperProcess$")
+		cmd.Env = append(os.Environ(), imageWorkerHelperEnv+"="+backendName)
+		return cmd
+	})
+	t.Cleanup(pool.Terminate)
+
+	return pool
+}
+
+func workerPid(t *testing.T, pool *ImageWorkerPool) uint {
+	t.Helper()
+
+	pid, _, err := pool.IdentifyDimension("pid")
+	if err != nil {
+		t.Fatalf("IdentifyDimension() returns error: %v", err)
+	}
+	return pid
+}
+
+func TestImageWorkerPool(t *testing.T) {
+	t.Run("Succeed", func(t *testing.T) {
+		pool := newTestImageWorkerPool(t, 1, 10*time.Second, "crashing")
+
+		width, height, err := pool.IdentifyDimension("photo.jpg")
+		if err != nil {
+			t.Fatalf("IdentifyDimension() returns error: %v", err)
+		}
+		if width != 64 || height != 32 {
+			t.Errorf("IdentifyDimension() = %dx%d, want 64x32", width, height)
+		}
+	})
+
+	t.Run("ErrorKeepsWorker", func(t *testing.T) {
+		pool := newTestImageWorkerPool(t, 1, 10*time.Second, "crashing")
+		pid := workerPid(t, pool)
+
+		_, _, err := pool.IdentifyDimension("error")
+		if err == nil || !strings.Contains(err.Error(), "unsupported image") {
+			t.Errorf("IdentifyDimension() = %v, want the error of the backend", err)
+		}
+
+		if got := workerPid(t, pool); got != pid {
+			t.Errorf("worker changed from %d to %d after an error, want it kept", pid, got)
+		}
+	})
+
+	for _, input := range []string{"crash", "panic"} {
+		t.Run("RestartAfter"+strings.ToUpper(input[:1])+input[1:], func(t *testing.T) {
+			pool := newTestImageWorkerPool(t, 1, 10*time.Second, "crashing")
+			pid := workerPid(t, pool)
+
+			_, _, err := pool.IdentifyDimension(input)
+			if !errors.Is(err, ErrImageWorkerCrashed) {
+				t.Fatalf("IdentifyDimension() = %v, want %v", err, ErrImageWorkerCrashed)
+			}
+			if !strings.Contains(err.Error(), input) {
+				t.Errorf("error %q does not name the input %q", err, input)
+			}
+
+			if got := workerPid(t, pool); got == pid {
+				t.Errorf("worker %d still used after crashing, want a new one", pid)
+			}
+		})
+	}
+
+	t.Run("Timeout", func(t *testing.T) {
+		pool := newTestImageWorkerPool(t, 1, 500*time.Millisecond, "crashing")
+		pid := workerPid(t, pool)
+
+		_, _, err := pool.IdentifyDimension("hang")
+		if !errors.Is(err, ErrImageWorkerTimeout) {
+			t.Fatalf("IdentifyDimension() = %v, want %v", err, ErrImageWorkerTimeout)
+		}
+
+		if got := workerPid(t, pool); got == pid {
+			t.Errorf("worker %d still used after timing out, want a new one", pid)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		pool := newTestImageWorkerPool(t, 2, 10*time.Second, "crashing")
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 8)
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				input := "photo.jpg"
+				if i%4 == 0 {
+					input = "crash"
+				}
+				if _, _, err := pool.IdentifyDimension(input); err != nil && input != "crash" {
+					errs <- err
+				}
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			t.Errorf("IdentifyDimension() of a valid photo returns error: %v", err)
+		}
+
+		pool.mutex.Lock()
+		workers := len(pool.workers)
+		pool.mutex.Unlock()
+		if workers > 2 {
+			t.Errorf("pool runs %d workers, want at most 2", workers)
+		}
+	})
+
+	t.Run("Terminated", func(t *testing.T) {
+		pool := newTestImageWorkerPool(t, 1, 10*time.Second, "crashing")
+		workerPid(t, pool)
+		pool.Terminate()
+
+		if _, _, err := pool.IdentifyDimension("photo.jpg"); !errors.Is(err, ErrImageWorkerPoolTerminated) {
+			t.Errorf("IdentifyDimension() = %v, want %v", err, ErrImageWorkerPoolTerminated)
+		}
+	})
+}
+
+func TestImageWorkerPoolConformance(t *testing.T) {
+	testImageBackendConformance(t, newTestImageWorkerPool(t, 2, time.Minute, imageBackendMagick))
+}
@@ -2,11 +2,13 @@ package executable_worker
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/kkovaletp/photoview/api/log"
 	"gopkg.in/gographics/imagick.v3/imagick"
 )
 
+// MagickWand is the image backend using ImageMagick through cgo.
 type MagickWand struct {
 	initialized bool
 }
@@ -80,6 +82,80 @@ func (cli *MagickWand) GenerateThumbnail(inputPath string, outputPath string, wi
 	return nil
 }
 
+// EditImage applies `edit` to `inputPath` and stores the result as a JPEG in `outputPath`.
+func (cli *MagickWand) EditImage(inputPath string, outputPath string, edit ImageEdit, jpegQuality uint) error {
+	wand, err := cli.createWandFromFile(inputPath)
+	if err != nil {
+		return err
+	}
+	defer wand.Destroy()
+
+	if edit.Rotation%360 != 0 {
+		background := imagick.NewPixelWand()
+		defer background.Destroy()
+		background.SetColor("black")
+
+		if err := wand.RotateImage(background, float64(edit.Rotation)); err != nil {
+			return fmt.Errorf("ImagickWand rotate %q error: %w", inputPath, err)
+		}
+	}
+
+	if edit.FlipHorizontal {
+		if err := wand.FlopImage(); err != nil {
+			return fmt.Errorf("ImagickWand flip %q horizontally error: %w", inputPath, err)
+		}
+	}
+
+	if edit.FlipVertical {
+		if err := wand.FlipImage(); err != nil {
+			return fmt.Errorf("ImagickWand flip %q vertically error: %w", inputPath, err)
+		}
+	}
+
+	if edit.HasCrop() {
+		width := float64(wand.GetImageWidth())
+		height := float64(wand.GetImageHeight())
+
+		cropWidth := uint(math.Max(1, math.Round(edit.CropWidth*width)))
+		cropHeight := uint(math.Max(1, math.Round(edit.CropHeight*height)))
+		if err := wand.CropImage(cropWidth, cropHeight, int(math.Round(edit.CropX*width)), int(math.Round(edit.CropY*height))); err != nil {
+			return fmt.Errorf("ImagickWand crop %q error: %w", inputPath, err)
+		}
+
+		// Drop the offset of the crop from the canvas, or it is kept in the output
+		if err := wand.ResetImagePage(""); err != nil {
+			return fmt.Errorf("ImagickWand reset page of %q error: %w", inputPath, err)
+		}
+	}
+
+	if edit.Exposure != 0 {
+		// Each stop doubles or halves the brightness
+		if err := wand.ModulateImage(100*math.Pow(2, edit.Exposure), 100, 100); err != nil {
+			return fmt.Errorf("ImagickWand adjust exposure of %q error: %w", inputPath, err)
+		}
+	}
+
+	if edit.Contrast != 0 {
+		if err := wand.BrightnessContrastImage(0, edit.Contrast); err != nil {
+			return fmt.Errorf("ImagickWand adjust contrast of %q error: %w", inputPath, err)
+		}
+	}
+
+	if err := wand.SetFormat("JPEG"); err != nil {
+		return fmt.Errorf("ImagickWand set JPEG format for %q error: %w", inputPath, err)
+	}
+
+	if err := wand.SetImageCompressionQuality(jpegQuality); err != nil {
+		return fmt.Errorf("ImagickWand set JPEG quality %d for %q error: %w", jpegQuality, inputPath, err)
+	}
+
+	if err := wand.WriteImage(outputPath); err != nil {
+		return fmt.Errorf("ImagickWand write %q error: %w", outputPath, err)
+	}
+
+	return nil
+}
+
 func (cli *MagickWand) IdentifyDimension(inputPath string) (width, height uint, reterr error) {
 	wand, err := cli.createWandFromFile(inputPath)
 	if err != nil {
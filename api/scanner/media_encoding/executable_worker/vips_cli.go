@@ -0,0 +1,272 @@
+package executable_worker
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kkovaletp/photoview/api/log"
+)
+
+// VipsCli is the image backend using the command line tools of libvips.
+// libvips streams images instead of decoding them whole, so it needs far less memory than ImageMagick on large files.
+// The formats it can decode, RAW files in particular, depend on the loaders libvips is built with.
+type VipsCli struct {
+	path       string
+	headerPath string
+	err        error
+}
+
+func newVipsCli() *VipsCli {
+	path, err := exec.LookPath("vips")
+	if err != nil {
+		log.Error(nil, "Executable vips worker not found")
+		return &VipsCli{
+			err: ErrNoDependency,
+		}
+	}
+
+	headerPath, err := exec.LookPath("vipsheader")
+	if err != nil {
+		log.Error(nil, "Executable vipsheader worker not found")
+		return &VipsCli{
+			err: ErrNoDependency,
+		}
+	}
+
+	version, err := exec.Command(path, "--version").Output()
+	if err != nil {
+		log.Error(nil, "Executable vips worker getting version error", "error", err)
+		return &VipsCli{
+			err: ErrNoDependency,
+		}
+	}
+
+	log.Info(nil, "Found executable worker: vips", "version", strings.TrimSpace(string(version)))
+
+	return &VipsCli{
+		path:       path,
+		headerPath: headerPath,
+	}
+}
+
+func (cli *VipsCli) IsInstalled() bool {
+	return cli.err == nil
+}
+
+func (cli *VipsCli) Terminate() {}
+
+func (cli *VipsCli) IdentifyDimension(inputPath string) (width, height uint, reterr error) {
+	if cli.err != nil {
+		return 0, 0, fmt.Errorf("identify dimension %q error: vips: %w", inputPath, cli.err)
+	}
+
+	header, err := cli.readHeader(inputPath)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	width, height = header.width, header.height
+
+	// Orientations 5 to 8 are rotated by 90 or 270 degrees
+	if header.orientation >= 5 && header.orientation <= 8 {
+		width, height = height, width
+	}
+
+	return width, height, nil
+}
+
+func (cli *VipsCli) GenerateThumbnail(inputPath string, outputPath string, width, height uint) error {
+	if cli.err != nil {
+		return fmt.Errorf("generate thumbnail %q error: vips: %w", inputPath, cli.err)
+	}
+
+	// The thumbnail operation rotates the image following its EXIF orientation
+	return cli.run("thumbnail", inputPath, jpegOutput(outputPath, 70), strconv.FormatUint(uint64(width), 10),
+		"--height", strconv.FormatUint(uint64(height), 10))
+}
+
+func (cli *VipsCli) EncodeJpeg(inputPath string, outputPath string, jpegQuality uint) error {
+	if cli.err != nil {
+		return fmt.Errorf("encode jpeg %q error: vips: %w", inputPath, cli.err)
+	}
+
+	return cli.run("autorot", inputPath, jpegOutput(outputPath, jpegQuality))
+}
+
+func (cli *VipsCli) EditImage(inputPath string, outputPath string, edit ImageEdit, jpegQuality uint) error {
+	if cli.err != nil {
+		return fmt.Errorf("edit image %q error: vips: %w", inputPath, cli.err)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "photoview-vips-")
+	if err != nil {
+		return fmt.Errorf("edit image %q error: %w", inputPath, err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Every step writes its result in the uncompressed vips format, read by the next step
+	current := inputPath
+	steps := 0
+	step := func(operation string, args ...string) error {
+		steps++
+		output := filepath.Join(tmpDir, strconv.Itoa(steps)+".v")
+		if err := cli.run(operation, append([]string{current, output}, args...)...); err != nil {
+			return err
+		}
+		current = output
+		return nil
+	}
+
+	if err := step("autorot"); err != nil {
+		return err
+	}
+
+	if angle := ((edit.Rotation % 360) + 360) % 360; angle != 0 {
+		if err := step("rot", "d"+strconv.Itoa(angle)); err != nil {
+			return err
+		}
+	}
+
+	if edit.FlipHorizontal {
+		if err := step("flip", "horizontal"); err != nil {
+			return err
+		}
+	}
+
+	if edit.FlipVertical {
+		if err := step("flip", "vertical"); err != nil {
+			return err
+		}
+	}
+
+	if edit.HasCrop() {
+		header, err := cli.readHeader(current)
+		if err != nil {
+			return err
+		}
+
+		width, height := float64(header.width), float64(header.height)
+		left := int(math.Round(edit.CropX * width))
+		top := int(math.Round(edit.CropY * height))
+		cropWidth := int(math.Max(1, math.Min(math.Round(edit.CropWidth*width), width-float64(left))))
+		cropHeight := int(math.Max(1, math.Min(math.Round(edit.CropHeight*height), height-float64(top))))
+
+		err = step("crop", strconv.Itoa(left), strconv.Itoa(top), strconv.Itoa(cropWidth), strconv.Itoa(cropHeight))
+		if err != nil {
+			return err
+		}
+	}
+
+	if edit.Exposure != 0 || edit.Contrast != 0 {
+		// The adjustments work on 8-bit sRGB values
+		if err := step("colourspace", "srgb"); err != nil {
+			return err
+		}
+
+		// Each stop doubles or halves the brightness, then the contrast stretches the values around the middle gray
+		slope := contrastSlope(edit.Contrast)
+		multiplier := math.Pow(2, edit.Exposure) * slope
+		offset := 127.5 * (1 - slope)
+
+		err := step("linear", strconv.FormatFloat(multiplier, 'f', -1, 64), strconv.FormatFloat(offset, 'f', -1, 64), "--uchar")
+		if err != nil {
+			return err
+		}
+	}
+
+	return cli.run("copy", current, jpegOutput(outputPath, jpegQuality))
+}
+
+// contrastSlope returns the slope ImageMagick applies to the values of an image for a contrast between -100 and 100.
+func contrastSlope(contrast float64) float64 {
+	// The slope is infinite at 100
+	contrast = math.Max(-100, math.Min(99.9, contrast))
+	return math.Tan(math.Pi * (contrast/100 + 1) / 4)
+}
+
+func (cli *VipsCli) run(operation string, args ...string) error {
+	args = append([]string{operation}, args...)
+
+	cmd := exec.Command(cli.path, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running vips %v error: %w: %s", args, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+type vipsHeader struct {
+	width       uint
+	height      uint
+	orientation int
+}
+
+// readHeader reads the dimension and EXIF orientation of `inputPath` from the fields listed by `vipsheader -a`.
+func (cli *VipsCli) readHeader(inputPath string) (vipsHeader, error) {
+	output, err := exec.Command(cli.headerPath, "-a", inputPath).Output()
+	if err != nil {
+		return vipsHeader{}, fmt.Errorf("reading header of %q with %q error: %w", inputPath, cli.headerPath, err)
+	}
+
+	header, err := parseVipsHeader(output)
+	if err != nil {
+		return vipsHeader{}, fmt.Errorf("reading header of %q error: %w", inputPath, err)
+	}
+
+	return header, nil
+}
+
+func parseVipsHeader(output []byte) (vipsHeader, error) {
+	var header vipsHeader
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		switch strings.TrimSpace(key) {
+		case "width":
+			width, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return header, fmt.Errorf("invalid width %q: %w", value, err)
+			}
+			header.width = uint(width)
+		case "height":
+			height, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return header, fmt.Errorf("invalid height %q: %w", value, err)
+			}
+			header.height = uint(height)
+		case "orientation":
+			// Only the leading number matters, some versions append a description
+			fields := strings.Fields(value)
+			if len(fields) > 0 {
+				header.orientation, _ = strconv.Atoi(fields[0])
+			}
+		}
+	}
+
+	if header.width == 0 || header.height == 0 {
+		return header, fmt.Errorf("dimension not found in vipsheader output")
+	}
+
+	return header, nil
+}
+
+// jpegOutput returns the vips output argument saving a JPEG of quality `jpegQuality` to `outputPath`.
+func jpegOutput(outputPath string, jpegQuality uint) string {
+	return fmt.Sprintf("%s[Q=%d]", outputPath, jpegQuality)
+}
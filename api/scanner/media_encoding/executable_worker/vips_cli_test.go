@@ -0,0 +1,100 @@
+package executable_worker
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVipsNotExist(t *testing.T) {
+	SetPathWithCurrent(t, "")
+
+	vips := newVipsCli()
+
+	if got, want := vips.err, ErrNoDependency; got != want {
+		t.Errorf("vips.err = %v, want: %v", got, want)
+	}
+
+	if vips.IsInstalled() {
+		t.Error("vips should not be installed, but is found:", vips)
+	}
+
+	if _, _, got := vips.IdentifyDimension("input"); !errors.Is(got, ErrNoDependency) {
+		t.Errorf("vips.IdentifyDimension() = %v, want: %v", got, ErrNoDependency)
+	}
+
+	if got := vips.GenerateThumbnail("input", "output", 1024, 1024); !errors.Is(got, ErrNoDependency) {
+		t.Errorf("vips.GenerateThumbnail() = %v, want: %v", got, ErrNoDependency)
+	}
+
+	if got := vips.EncodeJpeg("input", "output", 70); !errors.Is(got, ErrNoDependency) {
+		t.Errorf("vips.EncodeJpeg() = %v, want: %v", got, ErrNoDependency)
+	}
+
+	if got := vips.EditImage("input", "output", ImageEdit{CropWidth: 1, CropHeight: 1}, 70); !errors.Is(got, ErrNoDependency) {
+		t.Errorf("vips.EditImage() = %v, want: %v", got, ErrNoDependency)
+	}
+}
+
+func TestParseVipsHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    vipsHeader
+		wantErr bool
+	}{
+		{
+			"Plain",
+			"photo.jpg: 4000x3000 uchar, 3 bands, srgb, jpegload\nwidth: 4000\nheight: 3000\nbands: 3\n",
+			vipsHeader{width: 4000, height: 3000},
+			false,
+		},
+		{
+			"Oriented",
+			"width: 4000\nheight: 3000\norientation: 6\nexif-ifd0-Orientation: 6 (Right-top, Short, 1 components, 2 bytes)\n",
+			vipsHeader{width: 4000, height: 3000, orientation: 6},
+			false,
+		},
+		{
+			"MissingDimension",
+			"bands: 3\n",
+			vipsHeader{},
+			true,
+		},
+		{
+			"InvalidWidth",
+			"width: wide\nheight: 3000\n",
+			vipsHeader{},
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseVipsHeader([]byte(tc.output))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("parseVipsHeader() error = %v, want error: %v", err, tc.wantErr)
+			}
+			if !tc.wantErr && got != tc.want {
+				t.Errorf("parseVipsHeader() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContrastSlope(t *testing.T) {
+	if got := contrastSlope(0); got < 0.999 || got > 1.001 {
+		t.Errorf("contrastSlope(0) = %g, want 1", got)
+	}
+
+	if got := contrastSlope(50); got <= 1 {
+		t.Errorf("contrastSlope(50) = %g, want more than 1", got)
+	}
+
+	if got := contrastSlope(-50); got >= 1 || got <= 0 {
+		t.Errorf("contrastSlope(-50) = %g, want between 0 and 1", got)
+	}
+
+	if got := contrastSlope(100); got <= 0 {
+		t.Errorf("contrastSlope(100) = %g, want a finite positive slope", got)
+	}
+}
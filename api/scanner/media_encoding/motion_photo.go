@@ -0,0 +1,177 @@
+package media_encoding
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+// motionPhotoMarkerWindow is the number of bytes at the start and the end of a file searched for motion photo markers.
+const motionPhotoMarkerWindow = 64 * 1024
+
+// motionVideoSearchChunk is the number of bytes of a motion photo read at once when searching for its embedded video.
+const motionVideoSearchChunk = 256 * 1024
+
+// motionPhotoMarkers are found in the XMP metadata of Google motion photos, or in the trailer of Samsung ones.
+var motionPhotoMarkers = [][]byte{
+	[]byte("MotionPhoto"),
+	[]byte("MicroVideo"),
+}
+
+// videoBrands holds the major brands of the `ftyp` box of MP4 and QuickTime streams.
+// Image containers like HEIC also start with a `ftyp` box, but with brands like `heic` or `mif1`, which are not listed.
+var videoBrands = map[string]struct{}{
+	"isom": {},
+	"iso2": {},
+	"iso4": {},
+	"iso5": {},
+	"iso6": {},
+	"mp41": {},
+	"mp42": {},
+	"avc1": {},
+	"M4V ": {},
+	"qt  ": {},
+	"3gp4": {},
+	"3gp5": {},
+}
+
+// FindEmbeddedMotionVideo returns the offset of the MP4 stream that Google and Samsung motion photos append to the still image,
+// reading the file `r` of `size` bytes a chunk at a time. The stream runs up to the end of the file.
+func FindEmbeddedMotionVideo(r io.ReaderAt, size int64) (int64, bool, error) {
+	ftyp := []byte("ftyp")
+	buf := make([]byte, motionVideoSearchChunk)
+	header := make([]byte, 12)
+
+	// Start searching after the first box, so the `ftyp` box of a HEIC image is never taken for a video
+	for chunkStart := int64(8); chunkStart < size; {
+		n, err := r.ReadAt(buf, chunkStart)
+		if err != nil && err != io.EOF {
+			return 0, false, err
+		}
+		chunk := buf[:n]
+
+		for from := 0; ; {
+			idx := bytes.Index(chunk[from:], ftyp)
+			if idx < 0 {
+				break
+			}
+			idx += from
+			from = idx + len(ftyp)
+
+			boxStart := chunkStart + int64(idx) - 4
+			if boxStart+int64(len(header)) > size {
+				continue
+			}
+
+			if _, err := r.ReadAt(header, boxStart); err != nil && err != io.EOF {
+				return 0, false, err
+			}
+
+			boxSize := int64(binary.BigEndian.Uint32(header[:4]))
+			if boxSize >= 16 && boxStart+boxSize <= size {
+				if _, ok := videoBrands[string(header[8:12])]; ok {
+					return boxStart, true, nil
+				}
+			}
+		}
+
+		if chunkStart+int64(n) >= size {
+			break
+		}
+
+		// Chunks overlap, so a `ftyp` split between two of them is still found
+		chunkStart += int64(n - len(ftyp) + 1)
+	}
+
+	return 0, false, nil
+}
+
+// HasMotionPhotoMarker tells if `imagePath` is tagged as a motion photo, without reading the whole file.
+func HasMotionPhotoMarker(imagePath string) (bool, error) {
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+
+	buf := make([]byte, motionPhotoMarkerWindow)
+	offsets := []int64{0}
+	if stat.Size() > motionPhotoMarkerWindow {
+		offsets = append(offsets, stat.Size()-motionPhotoMarkerWindow)
+	}
+
+	for _, offset := range offsets {
+		n, err := file.ReadAt(buf, offset)
+		if err != nil && err != io.EOF {
+			return false, err
+		}
+
+		for _, marker := range motionPhotoMarkers {
+			if bytes.Contains(buf[:n], marker) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// ExtractEmbeddedMotionVideo writes the video embedded in the motion photo `imagePath` to `outputPath`.
+// It returns false, without creating `outputPath`, if the image is not tagged as a motion photo or holds no video.
+func ExtractEmbeddedMotionVideo(imagePath string, outputPath string) (bool, error) {
+	hasMarker, err := HasMotionPhotoMarker(imagePath)
+	if err != nil {
+		return false, fmt.Errorf("read motion photo %q error: %w", imagePath, err)
+	}
+
+	if !hasMarker {
+		return false, nil
+	}
+
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return false, fmt.Errorf("read motion photo %q error: %w", imagePath, err)
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return false, fmt.Errorf("read motion photo %q error: %w", imagePath, err)
+	}
+
+	offset, found, err := FindEmbeddedMotionVideo(file, stat.Size())
+	if err != nil {
+		return false, fmt.Errorf("read motion photo %q error: %w", imagePath, err)
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return false, fmt.Errorf("read motion photo %q error: %w", imagePath, err)
+	}
+
+	output, err := os.Create(outputPath)
+	if err != nil {
+		return false, fmt.Errorf("write embedded video of %q error: %w", imagePath, err)
+	}
+
+	if _, err := io.Copy(output, file); err != nil {
+		output.Close()
+		return false, fmt.Errorf("write embedded video of %q error: %w", imagePath, err)
+	}
+
+	if err := output.Close(); err != nil {
+		return false, fmt.Errorf("write embedded video of %q error: %w", imagePath, err)
+	}
+
+	return true, nil
+}
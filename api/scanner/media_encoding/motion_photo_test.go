@@ -0,0 +1,80 @@
+package media_encoding
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/kkovaletp/photoview/api/test_utils/flags"
+)
+
+func ftypBox(brand string) []byte {
+	return append([]byte{0, 0, 0, 24, 'f', 't', 'y', 'p'}, []byte(brand+"\x00\x00\x00\x00isommp42")...)
+}
+
+func TestFindEmbeddedMotionVideo(t *testing.T) {
+	jpeg := []byte{0xFF, 0xD8, 0xFF, 0xE1, 0, 0, 0, 0, 0, 0, 0xFF, 0xD9}
+	heic := append(ftypBox("heic"), 0, 0, 0, 8, 'm', 'e', 't', 'a')
+
+	tests := []struct {
+		name       string
+		data       []byte
+		wantOffset int64
+		wantFound  bool
+	}{
+		{"PlainJpeg", jpeg, 0, false},
+		{"JpegWithVideo", append(append([]byte{}, jpeg...), ftypBox("mp42")...), int64(len(jpeg)), true},
+		{"PlainHeic", heic, 0, false},
+		{"HeicWithVideo", append(append([]byte{}, heic...), ftypBox("isom")...), int64(len(heic)), true},
+		{"TruncatedBox", append(append([]byte{}, jpeg...), ftypBox("mp42")[:10]...), 0, false},
+		{"AcrossChunks", append(make([]byte, motionVideoSearchChunk+2), ftypBox("mp42")...), motionVideoSearchChunk + 2, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			offset, found, err := FindEmbeddedMotionVideo(bytes.NewReader(tc.data), int64(len(tc.data)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if offset != tc.wantOffset || found != tc.wantFound {
+				t.Errorf("FindEmbeddedMotionVideo() = (%d, %v), want (%d, %v)", offset, found, tc.wantOffset, tc.wantFound)
+			}
+		})
+	}
+}
+
+func TestExtractEmbeddedMotionVideo(t *testing.T) {
+	dir := t.TempDir()
+	video := append(ftypBox("mp42"), []byte("rest of the video")...)
+	image := append([]byte{0xFF, 0xD8}, []byte("<GCamera:MotionPhoto>1</GCamera:MotionPhoto>")...)
+	image = append(image, 0xFF, 0xD9)
+
+	imagePath := filepath.Join(dir, "motion.jpg")
+	if err := os.WriteFile(imagePath, append(image, video...), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	untaggedPath := filepath.Join(dir, "untagged.jpg")
+	if err := os.WriteFile(untaggedPath, append([]byte{0xFF, 0xD8, 0xFF, 0xD9}, video...), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if found, err := ExtractEmbeddedMotionVideo(untaggedPath, filepath.Join(dir, "untagged.mp4")); err != nil || found {
+		t.Errorf("ExtractEmbeddedMotionVideo(untagged) = (%v, %v), want (false, nil)", found, err)
+	}
+
+	outputPath := filepath.Join(dir, "motion.mp4")
+	found, err := ExtractEmbeddedMotionVideo(imagePath, outputPath)
+	if err != nil || !found {
+		t.Fatalf("ExtractEmbeddedMotionVideo() = (%v, %v), want (true, nil)", found, err)
+	}
+
+	got, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, video) {
+		t.Errorf("extracted video = %q, want %q", got, video)
+	}
+}
@@ -0,0 +1,16 @@
+package media_encoding
+
+import "time"
+
+// VideoPreviewWindow returns the offset and the length in seconds of the preview clip of a video of `duration` seconds.
+// The clip starts at 25% of the video, like the thumbnail, but starts earlier if it would otherwise run past the end.
+// Videos shorter than `length` are previewed as a whole.
+func VideoPreviewWindow(duration float64, length time.Duration) (start float64, clipLength float64) {
+	clipLength = length.Seconds()
+	if duration <= clipLength {
+		return 0, duration
+	}
+
+	start = min(duration*0.25, duration-clipLength)
+	return start, clipLength
+}
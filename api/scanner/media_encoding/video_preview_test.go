@@ -0,0 +1,34 @@
+package media_encoding
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/kkovaletp/photoview/api/test_utils/flags"
+)
+
+func TestVideoPreviewWindow(t *testing.T) {
+	tests := []struct {
+		name       string
+		duration   float64
+		wantStart  float64
+		wantLength float64
+	}{
+		{"ShorterThanClip", 2.5, 0, 2.5},
+		{"ClipRunsPastEnd", 5, 1, 4},
+		{"QuarterOfVideo", 60, 15, 4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			start, length := VideoPreviewWindow(tc.duration, 4*time.Second)
+
+			if start != tc.wantStart {
+				t.Errorf("start = %v, want %v", start, tc.wantStart)
+			}
+			if length != tc.wantLength {
+				t.Errorf("length = %v, want %v", length, tc.wantLength)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package media_encoding
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	videoSpriteTileWidth = 160
+	videoSpriteColumns   = 10
+	videoSpriteMaxFrames = 100
+)
+
+// VideoSpriteLayout describes how frames of a video are arranged in a scrubbing sprite sheet.
+type VideoSpriteLayout struct {
+	// Interval is the time in seconds between two frames
+	Interval   float64
+	Frames     int
+	Columns    int
+	Rows       int
+	TileWidth  int
+	TileHeight int
+}
+
+// NewVideoSpriteLayout returns the layout of a sprite sheet for a video of `duration` seconds, with a frame every `interval`.
+// For long videos the interval is stretched, so the sprite sheet never holds more than 100 frames.
+// TileHeight is unknown until the sprite sheet has been encoded, see SetSpriteHeight().
+func NewVideoSpriteLayout(duration float64, interval time.Duration) VideoSpriteLayout {
+	intervalSecs := interval.Seconds()
+	if duration/intervalSecs > videoSpriteMaxFrames {
+		intervalSecs = duration / videoSpriteMaxFrames
+	}
+
+	frames := max(int(math.Ceil(duration/intervalSecs)), 1)
+	columns := min(frames, videoSpriteColumns)
+	rows := (frames + columns - 1) / columns
+
+	return VideoSpriteLayout{
+		Interval:  intervalSecs,
+		Frames:    frames,
+		Columns:   columns,
+		Rows:      rows,
+		TileWidth: videoSpriteTileWidth,
+	}
+}
+
+// SetSpriteHeight derives the height of a single frame from the height of the encoded sprite sheet.
+func (l *VideoSpriteLayout) SetSpriteHeight(spriteHeight int) {
+	l.TileHeight = spriteHeight / l.Rows
+}
+
+// WebVTT returns a WebVTT thumbnail track, mapping each interval of the video to its frame in the sprite sheet `spriteURL`.
+func (l VideoSpriteLayout) WebVTT(spriteURL string, duration float64) string {
+	var sb strings.Builder
+	sb.WriteString("WEBVTT\n")
+
+	for i := 0; i < l.Frames; i++ {
+		start := float64(i) * l.Interval
+		end := math.Min(start+l.Interval, duration)
+
+		x := (i % l.Columns) * l.TileWidth
+		y := (i / l.Columns) * l.TileHeight
+
+		fmt.Fprintf(&sb, "\n%s --> %s\n%s#xywh=%d,%d,%d,%d\n",
+			formatVTTTimestamp(start), formatVTTTimestamp(end), spriteURL, x, y, l.TileWidth, l.TileHeight)
+	}
+
+	return sb.String()
+}
+
+func formatVTTTimestamp(seconds float64) string {
+	millis := int64(math.Round(seconds * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", millis/3600000, millis/60000%60, millis/1000%60, millis%1000)
+}
@@ -0,0 +1,83 @@
+package media_encoding
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/kkovaletp/photoview/api/test_utils/flags"
+)
+
+func TestNewVideoSpriteLayout(t *testing.T) {
+	tests := []struct {
+		name         string
+		duration     float64
+		interval     time.Duration
+		wantInterval float64
+		wantFrames   int
+		wantColumns  int
+		wantRows     int
+	}{
+		{"ShortVideo", 4, 10 * time.Second, 10, 1, 1, 1},
+		{"PartialRow", 65, 10 * time.Second, 10, 7, 7, 1},
+		{"MultipleRows", 125, 10 * time.Second, 10, 13, 10, 2},
+		{"StretchedInterval", 3000, 10 * time.Second, 30, 100, 10, 10},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewVideoSpriteLayout(tc.duration, tc.interval)
+
+			if got.Interval != tc.wantInterval {
+				t.Errorf("Interval = %v, want %v", got.Interval, tc.wantInterval)
+			}
+			if got.Frames != tc.wantFrames {
+				t.Errorf("Frames = %d, want %d", got.Frames, tc.wantFrames)
+			}
+			if got.Columns != tc.wantColumns {
+				t.Errorf("Columns = %d, want %d", got.Columns, tc.wantColumns)
+			}
+			if got.Rows != tc.wantRows {
+				t.Errorf("Rows = %d, want %d", got.Rows, tc.wantRows)
+			}
+			if got.TileWidth != videoSpriteTileWidth {
+				t.Errorf("TileWidth = %d, want %d", got.TileWidth, videoSpriteTileWidth)
+			}
+		})
+	}
+}
+
+func TestVideoSpriteLayoutWebVTT(t *testing.T) {
+	layout := NewVideoSpriteLayout(25, 10*time.Second)
+	layout.SetSpriteHeight(90)
+
+	want := `WEBVTT
+
+00:00:00.000 --> 00:00:10.000
+sprite.jpg#xywh=0,0,160,90
+
+00:00:10.000 --> 00:00:20.000
+sprite.jpg#xywh=160,0,160,90
+
+00:00:20.000 --> 00:00:25.000
+sprite.jpg#xywh=320,0,160,90
+`
+
+	if got := layout.WebVTT("sprite.jpg", 25); got != want {
+		t.Errorf("WebVTT() = %q, want %q", got, want)
+	}
+}
+
+func TestFormatVTTTimestamp(t *testing.T) {
+	tests := map[float64]string{
+		0:       "00:00:00.000",
+		1.5:     "00:00:01.500",
+		61.25:   "00:01:01.250",
+		3723.04: "01:02:03.040",
+	}
+
+	for seconds, want := range tests {
+		if got := formatVTTTimestamp(seconds); got != want {
+			t.Errorf("formatVTTTimestamp(%v) = %q, want %q", seconds, got, want)
+		}
+	}
+}
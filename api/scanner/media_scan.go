@@ -1,10 +1,16 @@
 package scanner
 
 import (
+	"context"
+	"path/filepath"
+
 	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
 	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_tasks"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_utils"
+	"github.com/kkovaletp/photoview/api/storage"
 	"github.com/pkg/errors"
 )
 
@@ -18,6 +24,11 @@ func scanMedia(ctx scanner_task.TaskContext, media *models.Media, mediaData *med
 	if err != nil {
 		return errors.Wrapf(err, "cache directory error (%s)", media.Path)
 	}
+	defer func() {
+		if err := storage.ReleaseWorkDir(media.AlbumID, media.ID, mediaCachePath); err != nil {
+			log.Warn(ctx, "Could not remove work directory of media", "path", mediaCachePath, "error", err)
+		}
+	}()
 
 	transactionError := newCtx.DatabaseTransaction(func(ctx scanner_task.TaskContext) error {
 		updatedURLs, err := scanner_tasks.Tasks.ProcessMedia(newCtx, mediaData, mediaCachePath)
@@ -25,6 +36,10 @@ func scanMedia(ctx scanner_task.TaskContext, media *models.Media, mediaData *med
 			return errors.Wrapf(err, "process media (%s)", media.Path)
 		}
 
+		if err = storeMediaFiles(ctx, media, mediaCachePath, updatedURLs); err != nil {
+			return errors.Wrapf(err, "store media files (%s)", media.Path)
+		}
+
 		if err = scanner_tasks.Tasks.AfterProcessMedia(newCtx, mediaData, updatedURLs, mediaIndex, mediaTotal); err != nil {
 			return errors.Wrap(err, "after process media")
 		}
@@ -38,3 +53,24 @@ func scanMedia(ctx scanner_task.TaskContext, media *models.Media, mediaData *med
 
 	return nil
 }
+
+// storeMediaFiles stores the files of `updatedURLs` generated in `workDir` in the storage of the media cache.
+func storeMediaFiles(ctx context.Context, media *models.Media, workDir string, updatedURLs []*models.MediaURL) error {
+	for _, mediaURL := range updatedURLs {
+		if mediaURL.Purpose == models.MediaOriginal {
+			continue
+		}
+
+		localPath := filepath.Join(workDir, mediaURL.MediaName)
+		if !scanner_utils.FileExists(localPath) {
+			continue
+		}
+
+		key := storage.MediaKey(media.AlbumID, media.ID, mediaURL.MediaName)
+		if err := storage.Default().Put(ctx, key, localPath, mediaURL.ContentType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,9 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/scanner/externaltools/exif"
 )
 
 // FindWebCounterpart returns the filename if the file `imagePath` has a counterpart file competible with the browser.
@@ -22,6 +25,35 @@ func FindRawCounterpart(imagePath string) (string, bool) {
 	})
 }
 
+// FindLivePhotoVideo returns the filename if the still image `imagePath` has a video counterpart, like the MOV of an iPhone Live Photo.
+// Only a video which is the motion of the image is a counterpart, see isLivePhotoMotion.
+func FindLivePhotoVideo(imagePath string) (string, bool) {
+	return findCounterpart(imagePath, func(filename string) bool {
+		return GetMediaType(filename).IsVideo() && isLivePhotoMotion(imagePath, filename)
+	})
+}
+
+// FindLivePhotoStill returns the filename if the video `videoPath` is the motion component of a still image.
+// If `webCompatibleOnly` is set, only stills which can be shown without processing them first are considered.
+func FindLivePhotoStill(videoPath string, webCompatibleOnly bool) (string, bool) {
+	return findCounterpart(videoPath, func(filename string) bool {
+		mt := GetMediaType(filename)
+		return mt.IsImage() && (mt.IsWebCompatible() || !webCompatibleOnly) && isLivePhotoMotion(filename, videoPath)
+	})
+}
+
+// isLivePhotoMotion tells if the video `videoPath` is the motion of the still image `stillPath` of the same name,
+// and not for example a movie next to its poster image.
+func isLivePhotoMotion(stillPath string, videoPath string) bool {
+	isMotion, err := exif.IsLivePhotoMotion(stillPath, videoPath)
+	if err != nil {
+		log.Warn(nil, "isLivePhotoMotion() error.", "error", err, "still", stillPath, "video", videoPath)
+		return false
+	}
+
+	return isMotion
+}
+
 func findCounterpart(filename string, acceptFn func(filepath string) bool) (string, bool) {
 	ext := path.Ext(filename)
 	filenamePattern := strings.TrimSuffix(filename, ext) + ".*"
@@ -44,6 +44,9 @@ var (
 	TypeMPEG = mediaType("video/mpeg")
 	TypeOGG  = mediaType("video/ogg")
 	TypeWEBM = mediaType("video/webm")
+
+	// Other formats
+	TypeHEIC = mediaType("image/heic")
 )
 
 var webImageMimetypes = arrayToSet([]MediaType{
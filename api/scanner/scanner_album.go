@@ -5,10 +5,13 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"time"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/scanner/media_archive"
 	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
+	"github.com/kkovaletp/photoview/api/scanner/media_type"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_tasks"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_utils"
@@ -105,18 +108,48 @@ func ScanAlbum(ctx scanner_task.TaskContext) error {
 	}
 	ctx = newCtx
 
+	album := ctx.GetAlbum()
+	isArchive := media_archive.IsArchive(album.Path)
+
+	var archiveModTime time.Time
+	if isArchive {
+		archiveInfo, err := os.Stat(album.Path)
+		if err != nil {
+			return errors.Wrapf(err, "read archive (%s)", album.Path)
+		}
+		archiveModTime = archiveInfo.ModTime().Truncate(time.Second)
+
+		// The media of an archive are only scanned again when the archive is modified
+		if album.ArchiveModTime != nil && album.ArchiveModTime.Unix() == archiveModTime.Unix() {
+			var albumMedia []*models.Media
+			if err := ctx.GetDB().Where("album_id = ?", album.ID).Find(&albumMedia).Error; err != nil {
+				return errors.Wrapf(err, "get media of unchanged archive (%s)", album.Path)
+			}
+
+			if err := scanner_tasks.Tasks.AfterScanAlbum(ctx, []*models.Media{}, albumMedia); err != nil {
+				return errors.Wrap(err, "after scan album")
+			}
+			return nil
+		}
+	}
+
 	// Scan for photos
-	albumMedia, err := findMediaForAlbum(ctx)
+	albumMedia, extracted, err := findMediaForAlbum(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "find media for album (%s): %s", ctx.GetAlbum().Path, err)
 	}
+	defer extracted.releaseAll()
 
 	changedMedia := make([]*models.Media, 0)
+	scanFailed := false
 	for i, media := range albumMedia {
 		mediaData := media_encoding.NewEncodeMediaData(media)
 
-		if err := scanMedia(ctx, media, &mediaData, i, len(albumMedia)); err != nil {
+		err := scanMedia(ctx, media, &mediaData, i, len(albumMedia))
+		extracted.release(media)
+		if err != nil {
 			scanner_utils.ScannerError(ctx, "Error scanning media for album (%d) file (%s): %s\n", ctx.GetAlbum().ID, media.Path, err)
+			scanFailed = true
 		}
 	}
 
@@ -124,16 +157,49 @@ func ScanAlbum(ctx scanner_task.TaskContext) error {
 		return errors.Wrap(err, "after scan album")
 	}
 
+	// Media which failed are scanned again the next time, even if the archive isn't modified until then
+	if isArchive && !scanFailed {
+		if err := ctx.GetDB().Model(album).Update("archive_mod_time", archiveModTime).Error; err != nil {
+			return errors.Wrapf(err, "save modification time of archive (%s)", album.Path)
+		}
+	}
+
 	return nil
 }
 
-func findMediaForAlbum(ctx scanner_task.TaskContext) ([]*models.Media, error) {
+// extractedFiles holds the files of archived media, extracted while finding them, until they are processed.
+// Each function removes the extracted file of its media.
+type extractedFiles map[*models.Media]func()
+
+// release removes the extracted file of `media`, if it has one.
+func (f extractedFiles) release(media *models.Media) {
+	if release, found := f[media]; found {
+		media.LocalPath = ""
+		release()
+		delete(f, media)
+	}
+}
+
+// releaseAll removes the extracted files which weren't released yet.
+func (f extractedFiles) releaseAll() {
+	for media := range f {
+		f.release(media)
+	}
+}
+
+// findMediaForAlbum finds the media of the album. The files of media in archives are extracted,
+// and kept until they are released.
+func findMediaForAlbum(ctx scanner_task.TaskContext) ([]*models.Media, extractedFiles, error) {
+
+	if media_archive.IsArchive(ctx.GetAlbum().Path) {
+		return findMediaInArchive(ctx)
+	}
 
 	albumMedia := make([]*models.Media, 0)
 
 	dirContent, err := os.ReadDir(ctx.GetAlbum().Path)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	for _, item := range dirContent {
@@ -149,11 +215,11 @@ func findMediaForAlbum(ctx scanner_task.TaskContext) ([]*models.Media, error) {
 		if !item.IsDir() && !isDirSymlink && ctx.GetCache().IsPathMedia(mediaPath) {
 			itemInfo, err := item.Info()
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			skip, err := scanner_tasks.Tasks.MediaFound(ctx, itemInfo, mediaPath)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			if skip {
 				continue
@@ -182,7 +248,85 @@ func findMediaForAlbum(ctx scanner_task.TaskContext) ([]*models.Media, error) {
 
 	}
 
-	return albumMedia, nil
+	return albumMedia, nil, nil
+}
+
+// findMediaInArchive finds the media of an album made from an archive, like findMediaForAlbum does for directories.
+// The media in subdirectories of the archive are part of the album too.
+func findMediaInArchive(ctx scanner_task.TaskContext) ([]*models.Media, extractedFiles, error) {
+	// The archive is opened once, as reading its list of files for each media takes as long as the list is
+	archive, err := media_archive.OpenArchive(ctx.GetAlbum().Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer archive.Close()
+
+	albumMedia := make([]*models.Media, 0)
+	extracted := make(extractedFiles)
+	for _, entry := range archive.Entries() {
+		log.Info(ctx, "Check the media", "media_path", entry.Path)
+
+		media, release, err := findArchivedMedia(ctx, archive, entry)
+		if err != nil {
+			scanner_utils.ScannerError(ctx, "Error scanning media for album (%d): %s\n", ctx.GetAlbum().ID, err)
+			continue
+		}
+
+		if media != nil {
+			albumMedia = append(albumMedia, media)
+			extracted[media] = release
+		}
+	}
+
+	return albumMedia, extracted, nil
+}
+
+// findArchivedMedia adds the media of an archive entry to the album, unless it isn't a media or is skipped.
+// The entry is extracted only once: the returned function removes the extracted file, which is the LocalPath
+// of the media until then, so it is processed from it too.
+func findArchivedMedia(ctx scanner_task.TaskContext, archive *media_archive.Archive, entry media_archive.Entry) (*models.Media, func(), error) {
+	localPath, release, err := archive.Extract(entry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	media, err := findExtractedMedia(ctx, entry, localPath)
+	if err != nil || media == nil {
+		release()
+		return nil, nil, err
+	}
+
+	return media, release, nil
+}
+
+func findExtractedMedia(ctx scanner_task.TaskContext, entry media_archive.Entry, localPath string) (*models.Media, error) {
+	ctx.GetCache().InsertFileInfo(entry.Path, entry.Info)
+	ctx.GetCache().InsertMediaType(entry.Path, media_type.GetMediaType(localPath))
+	if !ctx.GetCache().IsPathMedia(entry.Path) {
+		return nil, nil
+	}
+
+	skip, err := scanner_tasks.Tasks.MediaFound(ctx, entry.Info, entry.Path)
+	if err != nil || skip {
+		return nil, err
+	}
+
+	var media *models.Media
+	err = ctx.DatabaseTransaction(func(ctx scanner_task.TaskContext) error {
+		var isNewMedia bool
+		media, isNewMedia, err = ScanMedia(ctx.GetDB(), entry.Path, ctx.GetAlbum().ID, ctx.GetCache())
+		if err != nil {
+			return errors.Wrapf(err, "scanning media error (%s)", entry.Path)
+		}
+
+		media.LocalPath = localPath
+		return scanner_tasks.Tasks.AfterMediaFound(ctx, media, isNewMedia)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
 }
 
 func processMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData) ([]*models.MediaURL, error) {
@@ -1,13 +1,17 @@
 package scanner_test
 
 import (
+	"archive/zip"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/scanner"
 	"github.com/kkovaletp/photoview/api/test_utils"
+	scanner_utils "github.com/kkovaletp/photoview/api/test_utils/scanner"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const testDataPath = "./test_media/library"
@@ -112,3 +116,105 @@ func TestValidRootPath(t *testing.T) {
 		assert.True(t, scanner.ValidRootPath(testDataPath+"/./"))
 	})
 }
+
+// writeLibraryArchive writes an archive with photos of the test library, and a file which isn't media.
+func writeLibraryArchive(t *testing.T, archivePath string, photos ...string) {
+	t.Helper()
+
+	file, err := os.Create(archivePath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	for _, photo := range photos {
+		data, err := os.ReadFile(filepath.Join(testDataPath, photo))
+		require.NoError(t, err)
+
+		entry, err := writer.Create(photo)
+		require.NoError(t, err)
+		_, err = entry.Write(data)
+		require.NoError(t, err)
+	}
+
+	entry, err := writer.Create("notes.txt")
+	require.NoError(t, err)
+	_, err = entry.Write([]byte("not a photo"))
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Close())
+}
+
+func TestScanArchiveAlbum(t *testing.T) {
+	test_utils.FilesystemTest(t)
+	db := test_utils.DatabaseTest(t)
+
+	pass := "1234"
+	user, err := models.RegisterUser(db, "test_user", &pass, true)
+	require.NoError(t, err)
+
+	rootPath := t.TempDir()
+	archivePath := filepath.Join(rootPath, "export.zip")
+	writeLibraryArchive(t, archivePath, "buttercup_close_summer_yellow.jpg", "lilac_lilac_bush_lilac.jpg")
+
+	rootAlbum := models.Album{
+		Title: "root album",
+		Path:  rootPath,
+	}
+	require.NoError(t, db.Save(&rootAlbum).Error)
+	require.NoError(t, db.Model(user).Association("Albums").Append(&rootAlbum))
+
+	// The archived media are extracted into the temporary directory while they are scanned
+	extractDir := t.TempDir()
+	t.Setenv("TMPDIR", extractDir)
+
+	findArchiveAlbum := func(t *testing.T) []models.Album {
+		t.Helper()
+
+		var albums []models.Album
+		require.NoError(t, db.Where("path = ?", archivePath).Find(&albums).Error)
+		return albums
+	}
+
+	t.Run("Archives not scanned by default", func(t *testing.T) {
+		scanner_utils.RunScannerOnUser(t, db, user)
+
+		assert.Empty(t, findArchiveAlbum(t))
+	})
+
+	t.Run("Archive scanned as album", func(t *testing.T) {
+		require.NoError(t, db.Model(&rootAlbum).Update("scan_archives", true).Error)
+		scanner_utils.RunScannerOnUser(t, db, user)
+
+		albums := findArchiveAlbum(t)
+		require.Len(t, albums, 1)
+		assert.Equal(t, "export.zip", albums[0].Title)
+		assert.Equal(t, rootAlbum.ID, *albums[0].ParentAlbumID)
+
+		var media []models.Media
+		require.NoError(t, db.Preload("Exif").Where("album_id = ?", albums[0].ID).Order("path").Find(&media).Error)
+		require.Len(t, media, 2)
+
+		wantMedia := []struct {
+			path   string
+			camera string
+		}{
+			{filepath.Join(archivePath, "buttercup_close_summer_yellow.jpg"), "EX-FH20"},
+			{filepath.Join(archivePath, "lilac_lilac_bush_lilac.jpg"), "Canon EOS 500D"},
+		}
+		for i, want := range wantMedia {
+			assert.Equal(t, want.path, media[i].Path)
+			if assert.NotNil(t, media[i].Exif) && assert.NotNil(t, media[i].Exif.Camera) {
+				assert.Equal(t, want.camera, *media[i].Exif.Camera)
+			}
+
+			var thumbnailCount int64
+			require.NoError(t, db.Model(&models.MediaURL{}).
+				Where("media_id = ? AND purpose = ?", media[i].ID, models.PhotoThumbnail).Count(&thumbnailCount).Error)
+			assert.EqualValues(t, 1, thumbnailCount, "thumbnail of %s", media[i].Path)
+		}
+
+		extracted, err := os.ReadDir(extractDir)
+		require.NoError(t, err)
+		assert.Empty(t, extracted, "extracted files of archived media are removed after the scan")
+	})
+}
@@ -1,11 +1,12 @@
 package scanner_cache
 
 import (
+	"io/fs"
 	"log"
-	"os"
 	"path"
 	"sync"
 
+	"github.com/kkovaletp/photoview/api/scanner/media_archive"
 	"github.com/kkovaletp/photoview/api/scanner/media_type"
 )
 
@@ -13,6 +14,7 @@ type AlbumScannerCache struct {
 	path_contains_photos map[string]bool
 	photo_types          map[string]media_type.MediaType
 	ignore_data          map[string][]string
+	file_infos           map[string]fs.FileInfo
 	mutex                sync.Mutex
 }
 
@@ -21,6 +23,7 @@ func MakeAlbumCache() *AlbumScannerCache {
 		path_contains_photos: make(map[string]bool),
 		photo_types:          make(map[string]media_type.MediaType),
 		ignore_data:          make(map[string][]string),
+		file_infos:           make(map[string]fs.FileInfo),
 	}
 }
 
@@ -60,12 +63,17 @@ func (c *AlbumScannerCache) AlbumContainsPhotos(path string) *bool {
 	return nil
 }
 
-// func (c *AlbumScannerCache) InsertPhotoType(path string, content_type MediaType) {
-// 	c.mutex.Lock()
-// 	defer c.mutex.Unlock()
+// InsertMediaType stores the type of the media at `path`, when it was detected from a copy of the file
+func (c *AlbumScannerCache) InsertMediaType(path string, mediaType media_type.MediaType) {
+	if mediaType == media_type.TypeUnknown {
+		return
+	}
 
-// 	(c.photo_types)[path] = content_type
-// }
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.photo_types[path] = mediaType
+}
 
 func (c *AlbumScannerCache) GetMediaType(path string) media_type.MediaType {
 	c.mutex.Lock()
@@ -76,7 +84,7 @@ func (c *AlbumScannerCache) GetMediaType(path string) media_type.MediaType {
 		return result
 	}
 
-	mediaType := media_type.GetMediaType(path)
+	mediaType := getMediaType(path)
 	if mediaType == media_type.TypeUnknown {
 		return mediaType
 	}
@@ -86,6 +94,22 @@ func (c *AlbumScannerCache) GetMediaType(path string) media_type.MediaType {
 	return mediaType
 }
 
+// getMediaType detects the type of the media at `mediaPath`, which is extracted first if it is inside an archive
+func getMediaType(mediaPath string) media_type.MediaType {
+	if _, _, ok := media_archive.Split(mediaPath); !ok {
+		return media_type.GetMediaType(mediaPath)
+	}
+
+	localPath, release, err := media_archive.Extract(mediaPath)
+	defer release()
+	if err != nil {
+		log.Printf("Could not extract media %s to detect its type: %s\n", mediaPath, err)
+		return media_type.TypeUnknown
+	}
+
+	return media_type.GetMediaType(localPath)
+}
+
 func (c *AlbumScannerCache) GetAlbumIgnore(path string) *[]string {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
@@ -105,6 +129,33 @@ func (c *AlbumScannerCache) InsertAlbumIgnore(path string, ignoreData []string)
 	c.ignore_data[path] = ignoreData
 }
 
+// InsertFileInfo stores the file info of the media at `path`, when it is known already, like for the entries of an archive
+func (c *AlbumScannerCache) InsertFileInfo(path string, info fs.FileInfo) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.file_infos[path] = info
+}
+
+// GetFileInfo returns the file info of the media at `path`, which may be inside an archive, see media_archive.Stat
+func (c *AlbumScannerCache) GetFileInfo(path string) (fs.FileInfo, error) {
+	c.mutex.Lock()
+	info, found := c.file_infos[path]
+	c.mutex.Unlock()
+
+	if found {
+		return info, nil
+	}
+
+	info, err := media_archive.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.InsertFileInfo(path, info)
+	return info, nil
+}
+
 func (c *AlbumScannerCache) IsPathMedia(mediaPath string) bool {
 	mediaType := c.GetMediaType(mediaPath)
 
@@ -119,7 +170,7 @@ func (c *AlbumScannerCache) IsPathMedia(mediaPath string) bool {
 	}
 
 	// Make sure file isn't empty
-	fileStats, err := os.Stat(mediaPath)
+	fileStats, err := c.GetFileInfo(mediaPath)
 	if err != nil || fileStats.Size() == 0 {
 		return false
 	}
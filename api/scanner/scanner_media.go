@@ -3,15 +3,19 @@ package scanner
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"log"
-	"os"
 	"path"
+	"slices"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner/media_archive"
+	"github.com/kkovaletp/photoview/api/scanner/media_date"
 	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
 	"github.com/kkovaletp/photoview/api/scanner/media_type"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_cache"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_tasks"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
@@ -33,6 +37,14 @@ func ScanMedia(tx *gorm.DB, mediaPath string, albumId int, cache *scanner_cache.
 
 		if result.RowsAffected > 0 {
 			// log.Printf("Media already scanned: %s\n", mediaPath)
+			stat, err := cache.GetFileInfo(mediaPath)
+			if err != nil {
+				return nil, false, err
+			}
+
+			if err := checkMediaFileChanged(tx, media[0], stat); err != nil {
+				return nil, false, err
+			}
 			return media[0], false, nil
 		}
 	}
@@ -52,18 +64,22 @@ func ScanMedia(tx *gorm.DB, mediaPath string, albumId int, cache *scanner_cache.
 		mediaTypeText = models.MediaTypePhoto
 	}
 
-	stat, err := os.Stat(mediaPath)
+	stat, err := cache.GetFileInfo(mediaPath)
 	if err != nil {
 		return nil, false, err
 	}
 
+	dateShot, dateSource := media_date.Infer(mediaPath, stat.ModTime())
+
 	media := models.Media{
-		Title:    mediaName,
-		Path:     mediaPath,
-		AlbumID:  albumId,
-		Type:     mediaTypeText,
-		DateShot: stat.ModTime(),
+		Title:      mediaName,
+		Path:       mediaPath,
+		AlbumID:    albumId,
+		Type:       mediaTypeText,
+		DateShot:   dateShot,
+		DateSource: &dateSource,
 	}
+	media.SetFileInfo(stat.Size(), stat.ModTime())
 
 	if err := tx.Create(&media).Error; err != nil {
 		return nil, false, errors.Wrap(err, "could not insert media into database")
@@ -72,6 +88,26 @@ func ScanMedia(tx *gorm.DB, mediaPath string, albumId int, cache *scanner_cache.
 	return &media, true, nil
 }
 
+// checkMediaFileChanged sets FileChanged of the already scanned `media` if its file, with the file info `stat`,
+// changed since its metadata was read, so the scanner tasks read it again.
+func checkMediaFileChanged(tx *gorm.DB, media *models.Media, stat fs.FileInfo) error {
+	recorded := media.FileSize != nil && media.FileModTime != nil
+	media.FileChanged = media.SetFileInfo(stat.Size(), stat.ModTime())
+	if recorded && !media.FileChanged {
+		return nil
+	}
+
+	if media.FileChanged {
+		log.Printf("Media file changed: %s\n", media.Path)
+	}
+
+	if err := tx.Model(media).Select("file_size", "file_mod_time").Updates(media).Error; err != nil {
+		return errors.Wrap(err, "update file info of media")
+	}
+
+	return nil
+}
+
 // ProcessSingleMedia processes a single media, might be used to reprocess media with corrupted cache
 // Function waits for processing to finish before returning.
 func ProcessSingleMedia(ctx context.Context, db *gorm.DB, media *models.Media) error {
@@ -82,6 +118,12 @@ func ProcessSingleMedia(ctx context.Context, db *gorm.DB, media *models.Media) e
 		return err
 	}
 
+	release, err := extractArchivedMedia(media)
+	if err != nil {
+		return errors.Wrap(err, "single media scan")
+	}
+	defer release()
+
 	mediaData := media_encoding.NewEncodeMediaData(media)
 
 	taskContext := scanner_task.NewTaskContext(ctx, db, &album, albumCache)
@@ -91,3 +133,78 @@ func ProcessSingleMedia(ctx context.Context, db *gorm.DB, media *models.Media) e
 
 	return nil
 }
+
+// RefreshMediaMetadata reads the metadata of `media` again, as the scanner does when their files changed,
+// without processing them again. Failures are logged and the remaining media refreshed anyway,
+// until `ctx` is cancelled.
+func RefreshMediaMetadata(ctx context.Context, db *gorm.DB, media []*models.Media) {
+	albumIDs := make([]int, 0)
+	for _, m := range media {
+		if ctx.Err() != nil {
+			log.Printf("WARN: Refreshing metadata cancelled: %s\n", ctx.Err())
+			break
+		}
+
+		if err := refreshSingleMediaMetadata(ctx, db, m); err != nil {
+			log.Printf("ERROR: Could not refresh metadata of media %s: %s\n", m.Path, err)
+			continue
+		}
+
+		if !slices.Contains(albumIDs, m.AlbumID) {
+			albumIDs = append(albumIDs, m.AlbumID)
+		}
+	}
+
+	for _, albumID := range albumIDs {
+		if err := scanner_tasks.UpdateAlbumPlaces(db, albumID); err != nil {
+			log.Printf("WARN: Could not update places of album %d: %s\n", albumID, err)
+		}
+	}
+}
+
+func refreshSingleMediaMetadata(ctx context.Context, db *gorm.DB, media *models.Media) error {
+	var album models.Album
+	if err := db.Model(media).Association("Album").Find(&album); err != nil {
+		return err
+	}
+
+	release, err := extractArchivedMedia(media)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	taskContext := scanner_task.NewTaskContext(ctx, db, &album, scanner_cache.MakeAlbumCache())
+	return taskContext.DatabaseTransaction(func(ctx scanner_task.TaskContext) error {
+		stat, err := media_archive.Stat(media.Path)
+		if err != nil {
+			return err
+		}
+
+		if err := checkMediaFileChanged(ctx.GetDB(), media, stat); err != nil {
+			return err
+		}
+
+		media.FileChanged = true
+		return scanner_tasks.Tasks.AfterMediaFound(ctx, media, false)
+	})
+}
+
+// extractArchivedMedia extracts the file of `media` to process it, if it is inside an archive.
+// The returned function removes the extracted file again.
+func extractArchivedMedia(media *models.Media) (func(), error) {
+	if _, _, ok := media_archive.Split(media.Path); !ok {
+		return func() {}, nil
+	}
+
+	localPath, release, err := media_archive.Extract(media.Path)
+	if err != nil {
+		return func() {}, err
+	}
+
+	media.LocalPath = localPath
+	return func() {
+		media.LocalPath = ""
+		release()
+	}, nil
+}
@@ -0,0 +1,146 @@
+package scanner_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner"
+	"github.com/kkovaletp/photoview/api/test_utils"
+	scanner_utils "github.com/kkovaletp/photoview/api/test_utils/scanner"
+)
+
+func TestChangedMediaMetadata(t *testing.T) {
+	test_utils.FilesystemTest(t)
+	db := test_utils.DatabaseTest(t)
+
+	pass := "1234"
+	user, err := models.RegisterUser(db, "test_user", &pass, true)
+	if err != nil {
+		t.Fatal("register user error:", err)
+	}
+
+	library := test_utils.PathFromAPIRoot("scanner", "test_media", "library")
+	rootPath := t.TempDir()
+	photoPath := filepath.Join(rootPath, "photo.jpg")
+
+	// replacePhoto replaces the photo with a sample of another camera, with another size and modification time
+	modTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	replacePhoto := func(t *testing.T, sample string) {
+		t.Helper()
+
+		data, err := os.ReadFile(filepath.Join(library, sample))
+		if err != nil {
+			t.Fatal("read sample error:", err)
+		}
+
+		if err := os.WriteFile(photoPath, data, 0644); err != nil {
+			t.Fatal("write photo error:", err)
+		}
+
+		modTime = modTime.Add(time.Hour)
+		if err := os.Chtimes(photoPath, modTime, modTime); err != nil {
+			t.Fatal("change modification time of photo error:", err)
+		}
+	}
+	replacePhoto(t, "buttercup_close_summer_yellow.jpg")
+
+	rootAlbum := models.Album{
+		Title: "root album",
+		Path:  rootPath,
+	}
+
+	if err := db.Save(&rootAlbum).Error; err != nil {
+		t.Fatal("create root album error:", err)
+	}
+
+	if err := db.Model(user).Association("Albums").Append(&rootAlbum); err != nil {
+		t.Fatal("bind root album error:", err)
+	}
+
+	scanner_utils.RunScannerOnUser(t, db, user)
+
+	getPhoto := func(t *testing.T) *models.Media {
+		t.Helper()
+
+		var media models.Media
+		if err := db.Preload("Exif").Where("path = ?", photoPath).First(&media).Error; err != nil {
+			t.Fatal("get photo error:", err)
+		}
+
+		if media.Exif == nil {
+			t.Fatal("photo has no EXIF metadata")
+		}
+
+		return &media
+	}
+
+	// A user moves the photo and corrects its date
+	latitude, longitude := 52.37, 4.89
+	correctedDate := time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	photo := getPhoto(t)
+	photo.Exif.OverrideGPS(&latitude, &longitude)
+	if err := db.Save(photo.Exif).Error; err != nil {
+		t.Fatal("save GPS override error:", err)
+	}
+
+	photo.CorrectDateShot(correctedDate)
+	if err := db.Save(photo).Error; err != nil {
+		t.Fatal("save corrected date error:", err)
+	}
+
+	checkPhoto := func(t *testing.T, wantCamera string, wantFileDate string) {
+		t.Helper()
+
+		photo := getPhoto(t)
+
+		stat, err := os.Stat(photoPath)
+		if err != nil {
+			t.Fatal("stat photo error:", err)
+		}
+
+		if photo.FileSize == nil || *photo.FileSize != stat.Size() {
+			t.Errorf("FileSize = %v, want %d", photo.FileSize, stat.Size())
+		}
+
+		if photo.FileModTime == nil || photo.FileModTime.Unix() != modTime.Unix() {
+			t.Errorf("FileModTime = %v, want %s", photo.FileModTime, modTime)
+		}
+
+		if photo.Exif.Camera == nil || *photo.Exif.Camera != wantCamera {
+			t.Errorf("Camera = %v, want %q", photo.Exif.Camera, wantCamera)
+		}
+
+		if !photo.Exif.GPSOverridden || photo.Exif.GPSLatitude == nil || *photo.Exif.GPSLatitude != latitude ||
+			photo.Exif.GPSLongitude == nil || *photo.Exif.GPSLongitude != longitude {
+			t.Errorf("GPS = (%v, %v), overridden %v, want (%v, %v), overridden",
+				photo.Exif.GPSLatitude, photo.Exif.GPSLongitude, photo.Exif.GPSOverridden, latitude, longitude)
+		}
+
+		if !photo.DateShot.Equal(correctedDate) {
+			t.Errorf("DateShot = %s, want corrected date %s", photo.DateShot, correctedDate)
+		}
+
+		if photo.OriginalDateShot == nil || photo.OriginalDateShot.Format(time.DateTime) != wantFileDate {
+			t.Errorf("OriginalDateShot = %v, want %s", photo.OriginalDateShot, wantFileDate)
+		}
+	}
+
+	t.Run("Rescan", func(t *testing.T) {
+		replacePhoto(t, "lilac_lilac_bush_lilac.jpg")
+		scanner_utils.RunScannerOnUser(t, db, user)
+
+		checkPhoto(t, "Canon EOS 500D", "2014-05-05 16:40:11")
+	})
+
+	t.Run("RefreshMediaMetadata", func(t *testing.T) {
+		replacePhoto(t, "buttercup_close_summer_yellow.jpg")
+		scanner.RefreshMediaMetadata(context.Background(), db, []*models.Media{getPhoto(t)})
+
+		checkPhoto(t, "EX-FH20", "2014-04-27 19:12:41")
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"sync"
 	"time"
 
@@ -25,15 +26,27 @@ type ScannerJob struct {
 	ctx scanner_task.TaskContext
 	// album *models.Album
 	// cache *scanner_cache.AlbumScannerCache
+
+	// refresh is set for jobs reading the metadata of media of the album again, instead of scanning it
+	refresh *mediaRefresh
+}
+
+type mediaRefresh struct {
+	media []*models.Media
 }
 
 func NewScannerJob(ctx scanner_task.TaskContext) ScannerJob {
 	return ScannerJob{
-		ctx,
+		ctx: ctx,
 	}
 }
 
 func (job *ScannerJob) Run(db *gorm.DB) {
+	if job.refresh != nil {
+		scanner.RefreshMediaMetadata(job.ctx, db, job.refresh.media)
+		return
+	}
+
 	err := scanner.ScanAlbum(job.ctx)
 	if err != nil {
 		scanner_utils.ScannerError(nil, "Failed to scan album: %v", err)
@@ -139,10 +152,15 @@ func (queue *ScannerQueue) processQueue(notifyThrottle *utils.Throttle) {
 	maxJobs := queue.settings.max_concurrent_tasks
 	log.Printf("Queue running: in_progress: %d, max_tasks: %d, queue_len: %d\n", len(queue.in_progress), maxJobs, len(queue.up_next))
 
-	for len(queue.in_progress) < maxJobs && len(queue.up_next) > 0 {
+	for len(queue.in_progress) < maxJobs {
+		next := queue.nextJobIndex()
+		if next < 0 {
+			break
+		}
+
 		log.Println("Queue starting job")
-		nextJob := queue.up_next[0]
-		queue.up_next = queue.up_next[1:]
+		nextJob := queue.up_next[next]
+		queue.up_next = slices.Delete(queue.up_next, next, next+1)
 		queue.in_progress = append(queue.in_progress, nextJob)
 		jobNum := len(queue.in_progress)
 
@@ -238,6 +256,51 @@ func AddUserToQueue(user *models.User) error {
 	return nil
 }
 
+// AddMediaRefreshToQueue adds jobs reading the metadata of the given media again to the scanner queue,
+// one per album, so they never run along with a scan of the same album. Function does not block.
+func AddMediaRefreshToQueue(media []*models.Media) error {
+	if len(media) == 0 {
+		return nil
+	}
+
+	albumMedia := make(map[int][]*models.Media)
+	albumIDs := make([]int, 0)
+	for _, m := range media {
+		if _, found := albumMedia[m.AlbumID]; !found {
+			albumIDs = append(albumIDs, m.AlbumID)
+		}
+		albumMedia[m.AlbumID] = append(albumMedia[m.AlbumID], m)
+	}
+
+	var albums []*models.Album
+	if err := global_scanner_queue.db.Where("id IN (?)", albumIDs).Find(&albums).Error; err != nil {
+		return errors.Wrap(err, "get albums of media to refresh")
+	}
+
+	albumCache := scanner_cache.MakeAlbumCache()
+
+	global_scanner_queue.mutex.Lock()
+	for _, album := range albums {
+		global_scanner_queue.addJob(&ScannerJob{
+			ctx:     scanner_task.NewTaskContext(context.Background(), global_scanner_queue.db, album, albumCache),
+			refresh: &mediaRefresh{media: albumMedia[album.ID]},
+		})
+	}
+	global_scanner_queue.mutex.Unlock()
+
+	return nil
+}
+
+// nextJobIndex returns the index of the first waiting job whose album is not being processed by another job,
+// or -1 if there is none. Queue should be locked prior to calling this function
+func (queue *ScannerQueue) nextJobIndex() int {
+	return slices.IndexFunc(queue.up_next, func(job ScannerJob) bool {
+		return !slices.ContainsFunc(queue.in_progress, func(running ScannerJob) bool {
+			return running.ctx.GetAlbum().ID == job.ctx.GetAlbum().ID
+		})
+	})
+}
+
 // Queue should be locked prior to calling this function
 func (queue *ScannerQueue) addJob(job *ScannerJob) error {
 	if exists, err := queue.jobOnQueue(job); exists || err != nil {
@@ -255,6 +318,11 @@ func (queue *ScannerQueue) jobOnQueue(job *ScannerJob) (bool, error) {
 	scannerJobs := append(queue.in_progress, queue.up_next...)
 
 	for _, scannerJob := range scannerJobs {
+		// Refreshing metadata is never skipped, as it only covers some media of the album
+		if job.refresh != nil || scannerJob.refresh != nil {
+			continue
+		}
+
 		if scannerJob.ctx.GetAlbum().ID == job.ctx.GetAlbum().ID {
 			return true, nil
 		}
@@ -107,3 +107,46 @@ func TestScannerQueueJobOnQueue(t *testing.T) {
 	}
 
 }
+
+func makeRefreshJob(albumID int) ScannerJob {
+	job := makeScannerJob(albumID)
+	job.refresh = &mediaRefresh{media: []*models.Media{{AlbumID: albumID}}}
+
+	return job
+}
+
+func TestScannerQueueRefreshJobs(t *testing.T) {
+
+	mockScannerQueue := ScannerQueue{
+		idle_chan:   make(chan bool, 1),
+		in_progress: []ScannerJob{makeScannerJob(100)},
+		up_next:     []ScannerJob{makeScannerJob(20)},
+		db:          nil,
+	}
+
+	t.Run("refresh jobs are added along with scans of the album", func(t *testing.T) {
+		for _, job := range []ScannerJob{makeRefreshJob(100), makeRefreshJob(20), makeRefreshJob(20)} {
+			if err := mockScannerQueue.addJob(&job); err != nil {
+				t.Errorf(".AddJob() returned an unexpected error: %s", err)
+			}
+		}
+
+		if len(mockScannerQueue.up_next) != 4 {
+			t.Errorf("Expected scanner queue length to be %d but got %d", 4, len(mockScannerQueue.up_next))
+		}
+	})
+
+	t.Run("jobs wait for the jobs of their album in progress", func(t *testing.T) {
+		mockScannerQueue.up_next = []ScannerJob{makeRefreshJob(100), makeRefreshJob(20)}
+
+		if next := mockScannerQueue.nextJobIndex(); next != 1 {
+			t.Errorf("Expected next job to be %d but got %d", 1, next)
+		}
+
+		mockScannerQueue.in_progress = append(mockScannerQueue.in_progress, makeScannerJob(20))
+
+		if next := mockScannerQueue.nextJobIndex(); next != -1 {
+			t.Errorf("Expected no job to be startable but got %d", next)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package scanner_tasks
 
 import (
+	"context"
 	"fmt"
 	"image"
 	_ "image/jpeg"
@@ -11,6 +12,7 @@ import (
 	"github.com/kkovaletp/photoview/api/log"
 	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
+	"github.com/kkovaletp/photoview/api/storage"
 )
 
 type BlurhashTask struct {
@@ -58,11 +60,17 @@ func (t BlurhashTask) AfterProcessMedia(ctx scanner_task.TaskContext, mediaData
 
 // generateBlurhashFromThumbnail generates a blurhash for a single media and stores it in the database
 func generateBlurhashFromThumbnail(thumbnail *models.MediaURL) (string, error) {
-	path, err := thumbnail.CachedPath()
+	key, err := thumbnail.CacheKey()
 	if err != nil {
-		return "", fmt.Errorf("get path of media(id:%d) error: %w", thumbnail.MediaID, err)
+		return "", fmt.Errorf("get cache key of media(id:%d) error: %w", thumbnail.MediaID, err)
 	}
 
+	path, release, err := storage.LocalCopy(context.Background(), key, "")
+	if err != nil {
+		return "", fmt.Errorf("get thumbnail of media(id:%d) error: %w", thumbnail.MediaID, err)
+	}
+	defer release()
+
 	f, err := os.Open(path)
 	if err != nil {
 		return "", fmt.Errorf("open %q error: %w", path, err)
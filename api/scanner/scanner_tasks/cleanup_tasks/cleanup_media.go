@@ -1,14 +1,13 @@
 package cleanup_tasks
 
 import (
-	"os"
-	"path"
+	"context"
 	"strconv"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/scanner/face_detection"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_utils"
-	"github.com/kkovaletp/photoview/api/utils"
+	"github.com/kkovaletp/photoview/api/storage"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
@@ -40,10 +39,10 @@ func CleanupMedia(db *gorm.DB, albumId int, albumMedia []*models.Media) []error
 	for _, media := range mediaList {
 
 		mediaIDs = append(mediaIDs, media.ID)
-		cachePath := path.Join(utils.MediaCachePath(), strconv.Itoa(int(albumId)), strconv.Itoa(int(media.ID)))
-		err := os.RemoveAll(cachePath)
+		cachePrefix := storage.MediaPrefix(albumId, media.ID)
+		err := storage.Default().DeletePrefix(context.Background(), cachePrefix)
 		if err != nil {
-			deleteErrors = append(deleteErrors, errors.Wrapf(err, "delete unused cache folder (%s)", cachePath))
+			deleteErrors = append(deleteErrors, errors.Wrapf(err, "delete unused cache folder (%s)", cachePrefix))
 		}
 
 	}
@@ -100,10 +99,10 @@ func DeleteOldUserAlbums(db *gorm.DB, scannedAlbums []*models.Album, user *model
 	deleteAlbumIDs := make([]int, len(deleteAlbums))
 	for i, album := range deleteAlbums {
 		deleteAlbumIDs[i] = album.ID
-		cachePath := path.Join(utils.MediaCachePath(), strconv.Itoa(int(album.ID)))
-		err := os.RemoveAll(cachePath)
+		cachePrefix := strconv.Itoa(int(album.ID))
+		err := storage.Default().DeletePrefix(context.Background(), cachePrefix)
 		if err != nil {
-			deleteErrors = append(deleteErrors, errors.Wrapf(err, "delete unused cache folder (%s)", cachePath))
+			deleteErrors = append(deleteErrors, errors.Wrapf(err, "delete unused cache folder (%s)", cachePrefix))
 		}
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/log"
 	"github.com/kkovaletp/photoview/api/scanner/externaltools/exif"
+	"github.com/kkovaletp/photoview/api/scanner/media_date"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
 )
 
@@ -17,12 +18,18 @@ type ExifTask struct {
 }
 
 func (t ExifTask) AfterMediaFound(ctx scanner_task.TaskContext, media *models.Media, newMedia bool) error {
-	if !newMedia {
+	if newMedia {
+		if err := SaveEXIF(ctx.GetDB(), media); err != nil {
+			log.Warn(ctx, "SaveEXIF failed", "title", media.Title, "error", err, "path", media.Path)
+		}
 		return nil
 	}
 
-	if err := SaveEXIF(ctx.GetDB(), media); err != nil {
-		log.Warn(ctx, "SaveEXIF failed", "title", media.Title, "error", err, "path", media.Path)
+	if media.FileChanged {
+		log.Info(ctx, "Media file changed, reading the EXIF metadata again", "path", media.Path)
+		if err := RefreshEXIF(ctx.GetDB(), media); err != nil {
+			log.Warn(ctx, "RefreshEXIF failed", "title", media.Title, "error", err, "path", media.Path)
+		}
 	}
 
 	return nil
@@ -49,7 +56,7 @@ func SaveEXIF(tx *gorm.DB, media *models.Media) error {
 		media.ExifID = nil
 	}
 
-	exifData, err := exif.Parse(media.Path)
+	exifData, err := exif.Parse(media.FilePath())
 	if err != nil {
 		return fmt.Errorf("failed to parse exif data: %w", err)
 	}
@@ -63,11 +70,63 @@ func SaveEXIF(tx *gorm.DB, media *models.Media) error {
 		return fmt.Errorf("failed to save media exif to database: %w", err)
 	}
 
-	if exifData.DateShot != nil && !exifData.DateShot.Equal(media.DateShot) {
-		if err := tx.Save(media).Error; err != nil {
-			return fmt.Errorf("failed to update EXIF metadata for the media %s: %w", media.Path, err)
+	return saveEXIFDate(tx, media, exifData, false)
+}
+
+// RefreshEXIF reads the exif metadata of the media again after its file changed, and replaces the saved metadata.
+// Coordinates set by users are kept, see models.MediaEXIF.KeepGPSOverride, as are dates corrected by users.
+func RefreshEXIF(tx *gorm.DB, media *models.Media) error {
+	var saved []*models.MediaEXIF
+	if media.ExifID != nil {
+		if err := tx.Where("id = ?", *media.ExifID).Limit(1).Find(&saved).Error; err != nil {
+			return fmt.Errorf("failed to get EXIF for %q from database: %w", media.Path, err)
 		}
-		media.DateShot = *exifData.DateShot
+	}
+
+	if len(saved) == 0 {
+		media.ExifID = nil
+		return SaveEXIF(tx, media)
+	}
+
+	exifData, err := exif.Parse(media.FilePath())
+	if err != nil {
+		return fmt.Errorf("failed to parse exif data: %w", err)
+	}
+
+	if exifData == nil {
+		return nil
+	}
+
+	exifData.ID = saved[0].ID
+	exifData.CreatedAt = saved[0].CreatedAt
+	exifData.KeepGPSOverride(saved[0])
+
+	if err := tx.Save(exifData).Error; err != nil {
+		return fmt.Errorf("failed to save media exif to database: %w", err)
+	}
+	media.Exif = exifData
+
+	return saveEXIFDate(tx, media, exifData, true)
+}
+
+// saveEXIFDate uses the date of the exif metadata for the media, if it is preferred over the current source of its date.
+// With `refresh`, a date already read from the exif metadata is replaced too.
+func saveEXIFDate(tx *gorm.DB, media *models.Media, exifData *models.MediaEXIF, refresh bool) error {
+	if exifData.DateShot == nil || exifData.DateShotIsModTime {
+		return nil
+	}
+
+	fromEXIF := media.DateSource != nil && *media.DateSource == models.MediaDateSourceExif
+	if !media_date.Prefers(models.MediaDateSourceExif, media.DateSource) && !(refresh && fromEXIF) {
+		return nil
+	}
+
+	dateSource := models.MediaDateSourceExif
+	media.SetFileDateShot(*exifData.DateShot)
+	media.DateSource = &dateSource
+
+	if err := tx.Save(media).Error; err != nil {
+		return fmt.Errorf("failed to update EXIF metadata for the media %s: %w", media.Path, err)
 	}
 
 	return nil
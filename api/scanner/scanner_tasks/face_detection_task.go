@@ -22,6 +22,12 @@ func (t FaceDetectionTask) AfterProcessMedia(ctx scanner_task.TaskContext, media
 		if face_detection.GlobalFaceDetector == nil {
 			return nil
 		}
+
+		// The faces of an edited photo were moved along with the edits, instead of being detected twice
+		if mediaData.EditsChanged {
+			return nil
+		}
+
 		if err := face_detection.GlobalFaceDetector.DetectFaces(ctx.GetDB(), media); err != nil {
 			scanner_utils.ScannerError(ctx, "Error detecting faces in image (%s): %s", media.Path, err)
 		}
@@ -0,0 +1,70 @@
+package scanner_tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner/face_detection"
+	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type countingFaceDetector struct {
+	face_detection.FaceDetector
+	detected []int
+}
+
+func (d *countingFaceDetector) DetectFaces(db *gorm.DB, media *models.Media) error {
+	d.detected = append(d.detected, media.ID)
+	return nil
+}
+
+func TestFaceDetectionTaskSkipsEditedPhotos(t *testing.T) {
+	detector := &countingFaceDetector{}
+	previous := face_detection.GlobalFaceDetector
+	face_detection.GlobalFaceDetector = detector
+	defer func() { face_detection.GlobalFaceDetector = previous }()
+
+	tests := []struct {
+		name         string
+		mediaType    models.MediaType
+		editsChanged bool
+		updated      bool
+		wantDetected bool
+	}{
+		{"new photo", models.MediaTypePhoto, false, true, true},
+		{"edited photo", models.MediaTypePhoto, true, true, false},
+		{"unchanged photo", models.MediaTypePhoto, false, false, false},
+		{"video", models.MediaTypeVideo, false, true, false},
+	}
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open database error: %v", err)
+	}
+	ctx := scanner_task.NewTaskContext(context.Background(), db, &models.Album{}, nil)
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			detector.detected = nil
+			media := &models.Media{Model: models.Model{ID: i + 1}, Type: test.mediaType}
+			mediaData := media_encoding.EncodeMediaData{Media: media, EditsChanged: test.editsChanged}
+
+			var updatedURLs []*models.MediaURL
+			if test.updated {
+				updatedURLs = append(updatedURLs, &models.MediaURL{Purpose: models.PhotoThumbnail})
+			}
+
+			if err := (FaceDetectionTask{}).AfterProcessMedia(ctx, &mediaData, updatedURLs, 0, 1); err != nil {
+				t.Fatalf("AfterProcessMedia error: %v", err)
+			}
+
+			if got := len(detector.detected) > 0; got != test.wantDetected {
+				t.Errorf("faces detected = %v, want %v", got, test.wantDetected)
+			}
+		})
+	}
+}
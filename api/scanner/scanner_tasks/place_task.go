@@ -0,0 +1,105 @@
+package scanner_tasks
+
+import (
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/scanner/geocoding"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PlaceTask resolves the country, region and city of the geotagged media of an album offline,
+// when they are new or their GPS coordinates changed.
+type PlaceTask struct {
+	scanner_task.ScannerTaskBase
+}
+
+func (t PlaceTask) AfterScanAlbum(ctx scanner_task.TaskContext, changedMedia []*models.Media, albumMedia []*models.Media) error {
+	if err := UpdateAlbumPlaces(ctx.GetDB(), ctx.GetAlbum().ID); err != nil {
+		log.Warn(ctx, "Failed to update places of media", "album", ctx.GetAlbum().Path, "error", err)
+	}
+
+	return nil
+}
+
+// UpdateAlbumPlaces resolves the places of the media in the album whose GPS coordinates changed since they were
+// last resolved, and removes the places of media which lost their coordinates.
+func UpdateAlbumPlaces(db *gorm.DB, albumID int) error {
+	var rows []struct {
+		MediaID        int
+		Latitude       *float64
+		Longitude      *float64
+		PlaceLatitude  *float64
+		PlaceLongitude *float64
+	}
+
+	err := db.Table("media").
+		Select("media.id AS media_id, media_exif.gps_latitude AS latitude, media_exif.gps_longitude AS longitude, "+
+			"media_places.latitude AS place_latitude, media_places.longitude AS place_longitude").
+		Joins("LEFT JOIN media_exif ON media_exif.id = media.exif_id").
+		Joins("LEFT JOIN media_places ON media_places.media_id = media.id").
+		Where("media.album_id = ?", albumID).
+		Scan(&rows).Error
+	if err != nil {
+		return errors.Wrap(err, "get coordinates of media")
+	}
+
+	for _, row := range rows {
+		hasCoordinates := row.Latitude != nil && row.Longitude != nil
+		hasPlace := row.PlaceLatitude != nil && row.PlaceLongitude != nil
+
+		if hasCoordinates && hasPlace && *row.Latitude == *row.PlaceLatitude && *row.Longitude == *row.PlaceLongitude {
+			continue
+		}
+
+		if !hasCoordinates && !hasPlace {
+			continue
+		}
+
+		if err := SaveMediaPlace(db, row.MediaID, row.Latitude, row.Longitude); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SaveMediaPlace resolves and stores the place of the media at the coordinates, or removes it if they are nil.
+// A place is stored even if nothing was found at the coordinates, so they are not resolved again.
+func SaveMediaPlace(db *gorm.DB, mediaID int, latitude *float64, longitude *float64) error {
+	if latitude == nil || longitude == nil {
+		if err := db.Where("media_id = ?", mediaID).Delete(&models.MediaPlace{}).Error; err != nil {
+			return errors.Wrapf(err, "remove place of media %d", mediaID)
+		}
+		return nil
+	}
+
+	mediaPlace := models.MediaPlace{
+		MediaID:   mediaID,
+		Latitude:  *latitude,
+		Longitude: *longitude,
+	}
+
+	if place := geocoding.Reverse(*latitude, *longitude); place != nil {
+		mediaPlace.CountryCode = &place.CountryCode
+		mediaPlace.Country = &place.Country
+		if place.Region != "" {
+			mediaPlace.Region = &place.Region
+		}
+		if place.City != "" {
+			mediaPlace.City = &place.City
+		}
+	}
+
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "media_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"latitude", "longitude", "country_code", "country", "region", "city", "updated_at"}),
+	}).Create(&mediaPlace).Error
+	if err != nil {
+		return errors.Wrapf(err, "save place of media %d", mediaID)
+	}
+
+	return nil
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"io/fs"
 
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/scanner/media_archive"
 	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
 	"github.com/kkovaletp/photoview/api/scanner/media_type"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
@@ -15,12 +18,24 @@ type CounterpartFilesTask struct {
 }
 
 func (t CounterpartFilesTask) MediaFound(ctx scanner_task.TaskContext, fileInfo fs.FileInfo, mediaPath string) (skip bool, err error) {
+	// Counterpart files are not looked up inside archives, and the media type was already checked when extracting it
+	if _, _, ok := media_archive.Split(mediaPath); ok {
+		return false, nil
+	}
+
 	fileType := media_type.GetMediaType(mediaPath)
 
 	if !fileType.IsSupported() {
 		return true, nil
 	}
 
+	if fileType.IsVideo() {
+		// The motion component of a Live Photo is shown together with its still image
+		if _, existed := media_type.FindLivePhotoStill(mediaPath, utils.EnvDisableRawProcessing.GetBool()); existed {
+			return true, nil
+		}
+	}
+
 	if utils.EnvDisableRawProcessing.GetBool() {
 		if !fileType.IsWebCompatible() {
 			return true, nil
@@ -47,6 +62,12 @@ func (t CounterpartFilesTask) BeforeProcessMedia(ctx scanner_task.TaskContext, m
 		return ctx, fmt.Errorf("scan for counterpart file %s failed: media type is %s", mediaData.Media.Path, mediaType)
 	}
 
+	if mediaType.IsImage() {
+		if motionVideo, ok := media_type.FindLivePhotoVideo(mediaData.Media.Path); ok {
+			mediaData.MotionVideoPath = &motionVideo
+		}
+	}
+
 	if mediaType.IsWebCompatible() {
 		return ctx, nil
 	}
@@ -60,3 +81,18 @@ func (t CounterpartFilesTask) BeforeProcessMedia(ctx scanner_task.TaskContext, m
 
 	return ctx, nil
 }
+
+func (t CounterpartFilesTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData, mediaCachePath string) ([]*models.MediaURL, error) {
+	if mediaData.Media.Type != models.MediaTypePhoto {
+		return []*models.MediaURL{}, nil
+	}
+
+	updatedURLs, err := processMotionVideo(ctx.GetDB(), mediaData, mediaCachePath)
+	if err != nil {
+		// The still image is still usable without its motion
+		log.Warn(ctx, "Failed to process motion video of photo", "photo", mediaData.Media.Path, "error", err)
+		return []*models.MediaURL{}, nil
+	}
+
+	return updatedURLs, nil
+}
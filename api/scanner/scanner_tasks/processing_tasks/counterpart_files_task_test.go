@@ -61,6 +61,24 @@ func TestCounterpartFilesTaskMediaFound(t *testing.T) {
 			disableRawProcessing: true,
 			wantSkip:             true,
 		},
+		{
+			name:                 "LivePhotoVideo",
+			file:                 "live_photo.mov",
+			disableRawProcessing: false,
+			wantSkip:             true,
+		},
+		{
+			name:                 "LivePhotoStill",
+			file:                 "live_photo.jpg",
+			disableRawProcessing: false,
+			wantSkip:             false,
+		},
+		{
+			name:                 "StandaloneVideo",
+			file:                 "mp4.mp4",
+			disableRawProcessing: false,
+			wantSkip:             false,
+		},
 		{
 			name:                 "UnknownProcessRaw",
 			file:                 "file.pdf",
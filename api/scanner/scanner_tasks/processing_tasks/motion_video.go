@@ -0,0 +1,87 @@
+package processing_tasks
+
+import (
+	"os"
+	"path"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
+	"github.com/kkovaletp/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/kkovaletp/photoview/api/scanner/media_type"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// processMotionVideo makes sure the motion of a Live Photo or motion photo is available as a web compatible video.
+// The motion comes from the video counterpart of the still image, or else from the video embedded in the image itself.
+func processMotionVideo(tx *gorm.DB, mediaData *media_encoding.EncodeMediaData, mediaCachePath string) ([]*models.MediaURL, error) {
+	photo := mediaData.Media
+
+	motionURL, err := makePhotoURLChecker(tx, photo.ID)(models.MotionVideo)
+	if err != nil {
+		return nil, errors.Wrap(err, "error processing motion video")
+	}
+
+	if motionURL != nil && cachedFileExists(photo, motionURL.MediaName) {
+		return []*models.MediaURL{}, nil
+	}
+
+	if mediaData.MotionVideoPath == nil && !mayEmbedMotionVideo(mediaData) {
+		return []*models.MediaURL{}, nil
+	}
+
+	if motionURL == nil {
+		motionURL = &models.MediaURL{
+			MediaID:     photo.ID,
+			MediaName:   generateUniqueMediaNamePrefixed("motion_video", photo.Path, ".mp4"),
+			Purpose:     models.MotionVideo,
+			ContentType: "video/mp4",
+		}
+	}
+	motionPath := path.Join(mediaCachePath, motionURL.MediaName)
+
+	if mediaData.MotionVideoPath != nil {
+		if err := executable_worker.Ffmpeg.EncodeMp4(*mediaData.MotionVideoPath, motionPath); err != nil {
+			return nil, errors.Wrapf(err, "could not encode motion video (%s)", *mediaData.MotionVideoPath)
+		}
+	} else {
+		found, err := media_encoding.ExtractEmbeddedMotionVideo(photo.FilePath(), motionPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return []*models.MediaURL{}, nil
+		}
+	}
+
+	motionMetadata, err := ReadVideoStreamMetadata(motionPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read metadata for motion video (%s)", photo.Title)
+	}
+
+	fileStats, err := os.Stat(motionPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file stats of motion video")
+	}
+
+	motionURL.Width = motionMetadata.Width
+	motionURL.Height = motionMetadata.Height
+	motionURL.FileSize = fileStats.Size()
+
+	if err := tx.Save(motionURL).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to save motion video into database (%s)", photo.Title)
+	}
+
+	return []*models.MediaURL{motionURL}, nil
+}
+
+// mayEmbedMotionVideo tells if the photo is of a format Google and Samsung cameras embed the motion of motion photos in.
+func mayEmbedMotionVideo(mediaData *media_encoding.EncodeMediaData) bool {
+	contentType, err := mediaData.ContentType()
+	if err != nil {
+		return false
+	}
+
+	return contentType == media_type.TypeJPEG || contentType == media_type.TypeHEIC
+}
@@ -1,7 +1,6 @@
 package processing_tasks
 
 import (
-	"os"
 	"path"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
@@ -15,6 +14,24 @@ type ProcessPhotoTask struct {
 	scanner_task.ScannerTaskBase
 }
 
+// BeforeProcessMedia loads the edits of the photo, so every task encoding its high-res version applies them.
+func (t ProcessPhotoTask) BeforeProcessMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData) (scanner_task.TaskContext, error) {
+	if mediaData.Media.Type != models.MediaTypePhoto {
+		return ctx, nil
+	}
+
+	var edits []*models.MediaEdit
+	if err := ctx.GetDB().Where("media_id = ?", mediaData.Media.ID).Limit(1).Find(&edits).Error; err != nil {
+		return ctx, errors.Wrap(err, "get edits of photo")
+	}
+
+	if len(edits) > 0 {
+		mediaData.Edit = edits[0]
+	}
+
+	return ctx, nil
+}
+
 func (t ProcessPhotoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData, mediaCachePath string) ([]*models.MediaURL, error) {
 	if mediaData.Media.Type != models.MediaTypePhoto {
 		return []*models.MediaURL{}, nil
@@ -45,7 +62,10 @@ func (t ProcessPhotoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 		return []*models.MediaURL{}, errors.Wrap(err, "error processing photo highres")
 	}
 
-	var baseImagePath string = photo.Path
+	// Only a change of the edits drops the thumbnail of a photo while keeping its original
+	mediaData.EditsChanged = origURL != nil && thumbURL == nil
+
+	var baseImagePath string = photo.FilePath()
 
 	// Generate high res jpeg
 	if highResURL == nil {
@@ -55,7 +75,7 @@ func (t ProcessPhotoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 			return []*models.MediaURL{}, err
 		}
 
-		if !contentType.IsWebCompatible() {
+		if !contentType.IsWebCompatible() || mediaData.Edit != nil {
 			highresName := generateUniqueMediaNamePrefixed("highres", photo.Path, ".jpg")
 			baseImagePath = path.Join(mediaCachePath, highresName)
 
@@ -70,7 +90,7 @@ func (t ProcessPhotoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 		// Verify that highres photo still exists in cache
 		baseImagePath = path.Join(mediaCachePath, highResURL.MediaName)
 
-		if _, err := os.Stat(baseImagePath); os.IsNotExist(err) {
+		if !cachedFileExists(photo, highResURL.MediaName) {
 			log.Info(ctx, "High-res photo found in database but not in cache, re-encoding photo to cache", "media_name", highResURL.MediaName)
 			updatedURLs = append(updatedURLs, highResURL)
 
@@ -85,7 +105,7 @@ func (t ProcessPhotoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 	if origURL == nil {
 
 		// Make sure photo dimensions is set
-		photoDimensions, err := media_encoding.GetPhotoDimensions(photo.Path)
+		photoDimensions, err := media_encoding.GetPhotoDimensions(photo.FilePath())
 		if err != nil {
 			return []*models.MediaURL{}, err
 		}
@@ -98,6 +118,15 @@ func (t ProcessPhotoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 		updatedURLs = append(updatedURLs, original)
 	}
 
+	thumbMissing := thumbURL != nil && !cachedFileExists(photo, thumbURL.MediaName)
+
+	if highResURL != nil && (thumbURL == nil || thumbMissing) {
+		// The thumbnail is made from the high-res photo, which may only be in a remote storage
+		if err := fetchCachedFile(photo, highResURL.MediaName, baseImagePath); err != nil {
+			return []*models.MediaURL{}, err
+		}
+	}
+
 	// Save thumbnail to cache
 	if thumbURL == nil {
 		thumbnailName := generateUniqueMediaNamePrefixed("thumbnail", photo.Path, ".jpg")
@@ -109,9 +138,8 @@ func (t ProcessPhotoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 		updatedURLs = append(updatedURLs, thumbnail)
 	} else {
 		// Verify that thumbnail photo still exists in cache
-		thumbPath := path.Join(mediaCachePath, thumbURL.MediaName)
-
-		if _, err := os.Stat(thumbPath); os.IsNotExist(err) {
+		if thumbMissing {
+			thumbPath := path.Join(mediaCachePath, thumbURL.MediaName)
 			updatedURLs = append(updatedURLs, thumbURL)
 			log.Info(ctx, "Thumbnail photo found in database but not in cache, re-encoding photo to cache", "media_name", thumbURL.MediaName)
 
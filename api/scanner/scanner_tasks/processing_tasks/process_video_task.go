@@ -15,6 +15,7 @@ import (
 	"github.com/kkovaletp/photoview/api/utils"
 	"github.com/pkg/errors"
 	"gopkg.in/vansante/go-ffprobe.v2"
+	"gorm.io/gorm"
 )
 
 type ProcessVideoTask struct {
@@ -54,7 +55,7 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 	}
 
 	if videoOriginalURL == nil && videoType.IsWebCompatible() {
-		origVideoPath := video.Path
+		origVideoPath := video.FilePath()
 		videoMediaName := generateUniqueMediaName(video.Path)
 
 		webMetadata, err := ReadVideoStreamMetadata(origVideoPath)
@@ -92,7 +93,7 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 
 		webVideoPath := path.Join(mediaCachePath, webVideoName)
 
-		err = executable_worker.Ffmpeg.EncodeMp4(video.Path, webVideoPath)
+		err = executable_worker.Ffmpeg.EncodeMp4(video.FilePath(), webVideoPath)
 		if err != nil {
 			return []*models.MediaURL{}, errors.Wrapf(err, "could not encode mp4 video (%s)", video.Path)
 		}
@@ -122,6 +123,17 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 		}
 
 		updatedURLs = append(updatedURLs, &mediaURL)
+	} else if videoWebURL != nil {
+		// Verify that web video still exists in cache, it is evicted when the cache exceeds its limit
+		if !cachedFileExists(video, videoWebURL.MediaName) {
+			webVideoPath := path.Join(mediaCachePath, videoWebURL.MediaName)
+			log.Info(ctx, "Web video found in database but not in cache, re-encoding video to cache", "video", videoWebURL.MediaName)
+			updatedURLs = append(updatedURLs, videoWebURL)
+
+			if err := executable_worker.Ffmpeg.EncodeMp4(video.FilePath(), webVideoPath); err != nil {
+				return []*models.MediaURL{}, errors.Wrapf(err, "could not encode mp4 video (%s)", video.Path)
+			}
+		}
 	}
 
 	probeData, err := mediaData.VideoMetadata()
@@ -137,7 +149,7 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 
 		thumbImagePath := path.Join(mediaCachePath, videoThumbName)
 
-		err = executable_worker.Ffmpeg.EncodeVideoThumbnail(video.Path, thumbImagePath, probeData)
+		err = encodeVideoThumbnail(ctx.GetDB(), video, thumbImagePath, probeData)
 		if err != nil {
 			return []*models.MediaURL{}, errors.Wrapf(err, "failed to generate thumbnail for video (%s)", video.Title)
 		}
@@ -169,13 +181,12 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 		updatedURLs = append(updatedURLs, &thumbMediaURL)
 	} else {
 		// Verify that video thumbnail still exists in cache
-		thumbImagePath := path.Join(mediaCachePath, videoThumbnailURL.MediaName)
-
-		if _, err := os.Stat(thumbImagePath); os.IsNotExist(err) {
+		if !cachedFileExists(video, videoThumbnailURL.MediaName) {
+			thumbImagePath := path.Join(mediaCachePath, videoThumbnailURL.MediaName)
 			log.Info(ctx, "Video thumbnail found in database but not in cache, re-encoding video thumbnail to cache", "video", videoThumbnailURL.MediaName)
 			updatedURLs = append(updatedURLs, videoThumbnailURL)
 
-			err = executable_worker.Ffmpeg.EncodeVideoThumbnail(video.Path, thumbImagePath, probeData)
+			err = encodeVideoThumbnail(ctx.GetDB(), video, thumbImagePath, probeData)
 			if err != nil {
 				return []*models.MediaURL{}, errors.Wrapf(err, "failed to generate thumbnail for video (%s)", video.Title)
 			}
@@ -200,9 +211,45 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 		}
 	}
 
+	if spriteInterval := utils.VideoSpriteInterval(); spriteInterval > 0 && executable_worker.Ffmpeg.IsInstalled() {
+		spriteURLs, err := processVideoSprite(ctx.GetDB(), video, mediaCachePath, probeData, spriteInterval)
+		if err != nil {
+			// Sprites are only a scrubbing aid, the video is still usable without them
+			log.Warn(ctx, "Failed to generate scrubbing sprite for video", "video", video.Path, "error", err)
+		} else {
+			updatedURLs = append(updatedURLs, spriteURLs...)
+		}
+	}
+
+	if previewLength := utils.VideoPreviewLength(); previewLength > 0 && executable_worker.Ffmpeg.IsInstalled() {
+		previewURLs, err := processVideoPreview(ctx.GetDB(), video, mediaCachePath, probeData, previewLength)
+		if err != nil {
+			// The thumbnail is shown instead, when a video has no preview clip
+			log.Warn(ctx, "Failed to generate preview clip for video", "video", video.Path, "error", err)
+		} else {
+			updatedURLs = append(updatedURLs, previewURLs...)
+		}
+	}
+
 	return updatedURLs, nil
 }
 
+// encodeVideoThumbnail grabs the frame picked by the user as thumbnail, or lets ffmpeg pick one if there is none.
+func encodeVideoThumbnail(tx *gorm.DB, video *models.Media, thumbImagePath string, probeData *ffprobe.ProbeData) error {
+	if video.VideoMetadataID != nil {
+		var metadata models.VideoMetadata
+		if err := tx.Select("poster_time").First(&metadata, *video.VideoMetadataID).Error; err != nil {
+			return errors.Wrap(err, "get video poster time")
+		}
+
+		if metadata.PosterTime != nil {
+			return executable_worker.Ffmpeg.EncodeVideoThumbnailAt(video.FilePath(), thumbImagePath, *metadata.PosterTime)
+		}
+	}
+
+	return executable_worker.Ffmpeg.EncodeVideoThumbnail(video.FilePath(), thumbImagePath, probeData)
+}
+
 func ReadVideoMetadata(videoPath string) (*ffprobe.ProbeData, error) {
 	ctx, cancelFn := context.WithTimeout(context.Background(), utils.MediaProbeTimeout())
 	defer cancelFn()
@@ -1,12 +1,15 @@
 package processing_tasks
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
 	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_utils"
+	"github.com/kkovaletp/photoview/api/storage"
 	"github.com/kkovaletp/photoview/api/utils"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -58,7 +61,7 @@ func saveOriginalPhotoToDB(tx *gorm.DB, photo *models.Media, imageData *media_en
 		return nil, err
 	}
 
-	fileStats, err := os.Stat(photo.Path)
+	fileStats, err := os.Stat(photo.FilePath())
 	if err != nil {
 		return nil, errors.Wrap(err, "reading file stats of original photo")
 	}
@@ -79,3 +82,23 @@ func saveOriginalPhotoToDB(tx *gorm.DB, photo *models.Media, imageData *media_en
 
 	return &mediaURL, nil
 }
+
+// cachedFileExists tells if the file `mediaName` of `media` is in the storage of the media cache.
+func cachedFileExists(media *models.Media, mediaName string) bool {
+	_, err := storage.Default().Stat(context.Background(), storage.MediaKey(media.AlbumID, media.ID, mediaName))
+	return err == nil
+}
+
+// fetchCachedFile makes sure the file `mediaName` of `media` is at `localPath`,
+// copying it from the storage of the media cache unless it was just generated there.
+func fetchCachedFile(media *models.Media, mediaName string, localPath string) error {
+	if scanner_utils.FileExists(localPath) {
+		return nil
+	}
+
+	if err := storage.Default().Fetch(context.Background(), storage.MediaKey(media.AlbumID, media.ID, mediaName), localPath); err != nil {
+		return errors.Wrapf(err, "fetch %s from media cache", mediaName)
+	}
+
+	return nil
+}
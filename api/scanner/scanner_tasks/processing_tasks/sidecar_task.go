@@ -1,11 +1,7 @@
 package processing_tasks
 
 import (
-	"crypto/md5"
-	"encoding/hex"
 	"fmt"
-	"io"
-	"log"
 	"os"
 	"path"
 
@@ -15,6 +11,7 @@ import (
 	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_utils"
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
 )
 
 type SidecarTask struct {
@@ -35,19 +32,16 @@ func (t SidecarTask) AfterMediaFound(ctx scanner_task.TaskContext, media *models
 		return nil
 	}
 
-	var sideCarPath *string = nil
-	var sideCarHash *string = nil
-
-	sideCarPath = scanForSideCarFile(media.Path)
-	if sideCarPath != nil {
-		sideCarHash = hashSideCarFile(sideCarPath)
+	sideCarPath, sideCarHash, err := findSideCarFile(media.Path)
+	if err != nil {
+		return err
 	}
 
 	// Add sidecar data to media
 	media.SideCarPath = sideCarPath
 	media.SideCarHash = sideCarHash
 	if err := ctx.GetDB().Save(media).Error; err != nil {
-		return errors.Wrapf(err, "update media sidecar info (%s)", *sideCarPath)
+		return errors.Wrapf(err, "update media sidecar info (%s)", media.Path)
 	}
 
 	return nil
@@ -66,13 +60,20 @@ func (t SidecarTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media
 	photo := mediaData.Media
 
 	sideCarFileHasChanged := false
-	var currentFileHash *string
-	currentSideCarPath := scanForSideCarFile(photo.Path)
+	currentSideCarPath, currentFileHash, err := findSideCarFile(photo.Path)
+	if err != nil {
+		return []*models.MediaURL{}, errors.Wrap(err, "sidecar task, process media")
+	}
 
 	if currentSideCarPath != nil {
-		currentFileHash = hashSideCarFile(currentSideCarPath)
 		if photo.SideCarHash == nil || *photo.SideCarHash != *currentFileHash {
-			sideCarFileHasChanged = true
+			writtenBack, err := isWriteBackHash(ctx.GetDB(), photo.ID, *currentFileHash)
+			if err != nil {
+				return []*models.MediaURL{}, errors.Wrap(err, "sidecar task, check XMP write-back")
+			}
+
+			// Curation written back by Photoview itself doesn't change how the photo is rendered
+			sideCarFileHasChanged = !writtenBack
 		}
 	} else if photo.SideCarPath != nil { // sidecar has been deleted since last scan
 		sideCarFileHasChanged = true
@@ -96,6 +97,7 @@ func (t SidecarTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media
 
 	// update high res image may be cropped so dimentions and file size can change
 	baseImagePath := path.Join(mediaCachePath, highResURL.MediaName) // update base image path for thumbnail
+	mediaData.RenderSideCar = currentSideCarPath != nil
 	tempHighResPath := baseImagePath + ".hold"
 	os.Rename(baseImagePath, tempHighResPath)
 	updatedHighRes, err := generateSaveHighResJPEG(ctx.GetDB(), photo, mediaData, highResURL.MediaName, baseImagePath, highResURL)
@@ -130,31 +132,31 @@ func (t SidecarTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media
 	}, nil
 }
 
-func scanForSideCarFile(path string) *string {
-	testPath := path + ".xmp"
-
-	if scanner_utils.FileExists(testPath) {
-		return &testPath
+// isWriteBackHash reports whether the sidecar file of the media has the hash `hash`
+// from when Photoview last wrote curation like favorites to it, see scanner_tasks.WriteBackXMP.
+func isWriteBackHash(db *gorm.DB, mediaID int, hash string) (bool, error) {
+	var count int64
+	if err := db.Model(&models.MediaXMP{}).
+		Where("media_id = ? AND write_back_hash = ?", mediaID, hash).
+		Count(&count).Error; err != nil {
+		return false, err
 	}
 
-	return nil
+	return count > 0, nil
 }
 
-func hashSideCarFile(path *string) *string {
-	if path == nil {
-		return nil
+// findSideCarFile returns the path and hash of the XMP sidecar file of the media at `mediaPath`,
+// the same one the XMP metadata is read from and written back to, or nil if it has none.
+func findSideCarFile(mediaPath string) (sideCarPath *string, sideCarHash *string, err error) {
+	filePath := scanner_utils.FindXMPSidecar(mediaPath)
+	if filePath == "" {
+		return nil, nil, nil
 	}
 
-	f, err := os.Open(*path)
+	hash, err := scanner_utils.HashFile(filePath)
 	if err != nil {
-		log.Printf("ERROR: %s", err)
+		return nil, nil, errors.Wrapf(err, "hash sidecar file (%s)", filePath)
 	}
-	defer f.Close()
 
-	h := md5.New()
-	if _, err := io.Copy(h, f); err != nil {
-		log.Printf("ERROR: %s", err)
-	}
-	hash := hex.EncodeToString(h.Sum(nil))
-	return &hash
+	return &filePath, &hash, nil
 }
@@ -0,0 +1,69 @@
+package processing_tasks
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
+	"github.com/kkovaletp/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/pkg/errors"
+	"gopkg.in/vansante/go-ffprobe.v2"
+	"gorm.io/gorm"
+)
+
+// processVideoPreview makes sure the short silent clip, that is played when hovering a video in the grid, is present.
+func processVideoPreview(tx *gorm.DB, video *models.Media, mediaCachePath string, probeData *ffprobe.ProbeData,
+	length time.Duration) ([]*models.MediaURL, error) {
+
+	previewURL, err := makePhotoURLChecker(tx, video.ID)(models.VideoPreview)
+	if err != nil {
+		return nil, errors.Wrap(err, "error processing video preview")
+	}
+
+	if previewURL != nil && cachedFileExists(video, previewURL.MediaName) {
+		return []*models.MediaURL{}, nil
+	}
+
+	duration := probeData.Format.DurationSeconds
+	if duration <= 0 {
+		return nil, fmt.Errorf("video has no duration (%s)", video.Title)
+	}
+
+	if previewURL == nil {
+		previewURL = &models.MediaURL{
+			MediaID:     video.ID,
+			MediaName:   generateUniqueMediaNamePrefixed("video_preview", video.Path, ".mp4"),
+			Purpose:     models.VideoPreview,
+			ContentType: "video/mp4",
+		}
+	}
+	previewPath := path.Join(mediaCachePath, previewURL.MediaName)
+
+	start, clipLength := media_encoding.VideoPreviewWindow(duration, length)
+	if err := executable_worker.Ffmpeg.EncodeVideoPreview(video.FilePath(), previewPath, start, clipLength); err != nil {
+		return nil, errors.Wrapf(err, "failed to generate preview clip for video (%s)", video.Title)
+	}
+
+	previewMetadata, err := ReadVideoStreamMetadata(previewPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read metadata for preview clip (%s)", video.Title)
+	}
+
+	fileStats, err := os.Stat(previewPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file stats of video preview")
+	}
+
+	previewURL.Width = previewMetadata.Width
+	previewURL.Height = previewMetadata.Height
+	previewURL.FileSize = fileStats.Size()
+
+	if err := tx.Save(previewURL).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to save preview clip of video into database (%s)", video.Title)
+	}
+
+	return []*models.MediaURL{previewURL}, nil
+}
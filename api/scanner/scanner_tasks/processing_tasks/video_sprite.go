@@ -0,0 +1,104 @@
+package processing_tasks
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
+	"github.com/kkovaletp/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/pkg/errors"
+	"gopkg.in/vansante/go-ffprobe.v2"
+	"gorm.io/gorm"
+)
+
+// processVideoSprite makes sure the scrubbing sprite sheet of a video and the WebVTT track pointing into it are present.
+// Both files are always generated together, as the track depends on the name and layout of the sprite sheet.
+func processVideoSprite(tx *gorm.DB, video *models.Media, mediaCachePath string, probeData *ffprobe.ProbeData,
+	interval time.Duration) ([]*models.MediaURL, error) {
+
+	mediaURLFromDB := makePhotoURLChecker(tx, video.ID)
+
+	spriteURL, err := mediaURLFromDB(models.VideoSprite)
+	if err != nil {
+		return nil, errors.Wrap(err, "error processing video sprite")
+	}
+
+	vttURL, err := mediaURLFromDB(models.VideoVTT)
+	if err != nil {
+		return nil, errors.Wrap(err, "error processing video thumbnail track")
+	}
+
+	if spriteURL != nil && vttURL != nil &&
+		cachedFileExists(video, spriteURL.MediaName) && cachedFileExists(video, vttURL.MediaName) {
+		return []*models.MediaURL{}, nil
+	}
+
+	duration := probeData.Format.DurationSeconds
+	if duration <= 0 {
+		return nil, fmt.Errorf("video has no duration (%s)", video.Title)
+	}
+
+	layout := media_encoding.NewVideoSpriteLayout(duration, interval)
+
+	if spriteURL == nil {
+		spriteURL = &models.MediaURL{
+			MediaID:     video.ID,
+			MediaName:   generateUniqueMediaNamePrefixed("video_sprite", video.Path, ".jpg"),
+			Purpose:     models.VideoSprite,
+			ContentType: "image/jpeg",
+		}
+	}
+	spritePath := path.Join(mediaCachePath, spriteURL.MediaName)
+
+	err = executable_worker.Ffmpeg.EncodeVideoSprite(video.FilePath(), spritePath, layout.Interval, layout.TileWidth,
+		layout.Columns, layout.Rows)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate sprite for video (%s)", video.Title)
+	}
+
+	spriteDimensions, err := media_encoding.GetPhotoDimensions(spritePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "get dimensions of video sprite image")
+	}
+	layout.SetSpriteHeight(spriteDimensions.Height)
+
+	spriteStats, err := os.Stat(spritePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file stats of video sprite")
+	}
+
+	spriteURL.Width = spriteDimensions.Width
+	spriteURL.Height = spriteDimensions.Height
+	spriteURL.FileSize = spriteStats.Size()
+
+	if vttURL == nil {
+		vttURL = &models.MediaURL{
+			MediaID:     video.ID,
+			MediaName:   generateUniqueMediaNamePrefixed("video_vtt", video.Path, ".vtt"),
+			Purpose:     models.VideoVTT,
+			ContentType: "text/vtt",
+		}
+	}
+	vttPath := path.Join(mediaCachePath, vttURL.MediaName)
+
+	// The sprite is referenced by its name, which is replaced by its URL when the track is served
+	vtt := []byte(layout.WebVTT(spriteURL.MediaName, duration))
+	if err := os.WriteFile(vttPath, vtt, 0644); err != nil {
+		return nil, errors.Wrapf(err, "failed to write thumbnail track for video (%s)", video.Title)
+	}
+
+	vttURL.Width = layout.TileWidth
+	vttURL.Height = layout.TileHeight
+	vttURL.FileSize = int64(len(vtt))
+
+	for _, mediaURL := range []*models.MediaURL{spriteURL, vttURL} {
+		if err := tx.Save(mediaURL).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to save %s of video into database (%s)", mediaURL.Purpose, video.Title)
+		}
+	}
+
+	return []*models.MediaURL{spriteURL, vttURL}, nil
+}
@@ -22,8 +22,11 @@ var allTasks []scanner_task.ScannerTask = []scanner_task.ScannerTask{
 	FaceDetectionTask{},
 	BlurhashTask{},
 	ExifTask{},
+	XMPTask{},
 	VideoMetadataTask{},
+	PlaceTask{},
 	cleanup_tasks.MediaCleanupTask{},
+	StackTask{},
 }
 
 type scannerTasks struct {
@@ -0,0 +1,236 @@
+package scanner_tasks
+
+import (
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// StackTask groups near-identical media of an album into stacks: photos of a burst, photos taken at the same instant
+// by the same camera, like HDR brackets, and files sharing a name, like edited exports.
+type StackTask struct {
+	scanner_task.ScannerTaskBase
+}
+
+func (t StackTask) AfterScanAlbum(ctx scanner_task.TaskContext, changedMedia []*models.Media, albumMedia []*models.Media) error {
+	if err := UpdateAlbumStacks(ctx.GetDB(), ctx.GetAlbum().ID, albumMedia); err != nil {
+		log.Warn(ctx, "Failed to update media stacks of album", "album", ctx.GetAlbum().Path, "error", err)
+	}
+
+	return nil
+}
+
+// UpdateAlbumStacks rebuilds the automatic stacks of the album from `albumMedia`.
+// Manual stacks and media a user took out of a stack are left as is.
+func UpdateAlbumStacks(db *gorm.DB, albumID int, albumMedia []*models.Media) error {
+	mediaIDs := make([]int, len(albumMedia))
+	for i, media := range albumMedia {
+		mediaIDs[i] = media.ID
+	}
+
+	var stacks []*models.MediaStack
+	if err := db.Where("album_id = ?", albumID).Find(&stacks).Error; err != nil {
+		return errors.Wrap(err, "get media stacks of album")
+	}
+
+	manualStacks := make(map[int]bool, len(stacks))
+	stackIDs := make([]int, len(stacks))
+	for i, stack := range stacks {
+		manualStacks[stack.ID] = stack.Manual
+		stackIDs[i] = stack.ID
+	}
+
+	candidates := make([]*models.Media, 0, len(albumMedia))
+	if len(mediaIDs) > 0 {
+		err := db.Preload("Exif").
+			Where("id IN (?) AND stack_excluded = ?", mediaIDs, false).
+			Order("path").
+			Find(&candidates).Error
+		if err != nil {
+			return errors.Wrap(err, "get media of album to stack")
+		}
+	}
+
+	// Members of manual stacks stay where the user put them
+	autoCandidates := candidates[:0]
+	for _, media := range candidates {
+		if media.StackID == nil || !manualStacks[*media.StackID] {
+			autoCandidates = append(autoCandidates, media)
+		}
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		stacked := make(map[int]bool)
+		usedStacks := make(map[int]bool)
+
+		for _, group := range groupStackCandidates(autoCandidates) {
+			stack, err := autoStackForGroup(tx, albumID, group, manualStacks, usedStacks)
+			if err != nil {
+				return err
+			}
+			usedStacks[stack.ID] = true
+
+			memberIDs := make([]int, len(group))
+			for i, media := range group {
+				memberIDs[i] = media.ID
+				stacked[media.ID] = true
+			}
+
+			if err := tx.Model(&models.Media{}).Where("id IN (?)", memberIDs).Update("stack_id", stack.ID).Error; err != nil {
+				return errors.Wrap(err, "add media to stack")
+			}
+		}
+
+		for _, media := range autoCandidates {
+			if media.StackID != nil && !stacked[media.ID] {
+				if err := tx.Model(media).Update("stack_id", nil).Error; err != nil {
+					return errors.Wrap(err, "remove media from stack")
+				}
+			}
+		}
+
+		return models.RepairMediaStacks(tx, stackIDs)
+	})
+}
+
+// autoStackForGroup returns the automatic stack most members of `group` are already in, or creates a new one.
+// Stacks in `usedStacks` already hold another group and are not reused.
+func autoStackForGroup(tx *gorm.DB, albumID int, group []*models.Media, manualStacks map[int]bool,
+	usedStacks map[int]bool) (*models.MediaStack, error) {
+
+	counts := make(map[int]int)
+	bestID := 0
+	for _, media := range group {
+		if media.StackID == nil || manualStacks[*media.StackID] || usedStacks[*media.StackID] {
+			continue
+		}
+
+		id := *media.StackID
+		counts[id]++
+		if bestID == 0 || counts[id] > counts[bestID] || (counts[id] == counts[bestID] && id < bestID) {
+			bestID = id
+		}
+	}
+
+	if bestID == 0 {
+		stack := models.MediaStack{
+			AlbumID: albumID,
+			CoverID: group[0].ID,
+		}
+
+		if err := tx.Create(&stack).Error; err != nil {
+			return nil, errors.Wrap(err, "create media stack")
+		}
+
+		return &stack, nil
+	}
+
+	var stack models.MediaStack
+	if err := tx.First(&stack, bestID).Error; err != nil {
+		return nil, errors.Wrap(err, "get media stack")
+	}
+
+	for _, media := range group {
+		if media.ID == stack.CoverID {
+			return &stack, nil
+		}
+	}
+
+	if err := tx.Model(&stack).Update("cover_id", group[0].ID).Error; err != nil {
+		return nil, errors.Wrap(err, "update cover of media stack")
+	}
+
+	return &stack, nil
+}
+
+// groupStackCandidates returns the groups of at least two media which belong in the same stack.
+// Media in a group keep the order of `media`.
+func groupStackCandidates(media []*models.Media) [][]*models.Media {
+	parent := make([]int, len(media))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	firstWithKey := make(map[string]int)
+	for i, m := range media {
+		for _, key := range stackKeys(m) {
+			if j, ok := firstWithKey[key]; ok {
+				parent[find(i)] = find(j)
+			} else {
+				firstWithKey[key] = i
+			}
+		}
+	}
+
+	groupIndex := make(map[int]int)
+	groups := make([][]*models.Media, 0)
+	for i, m := range media {
+		root := find(i)
+		if idx, ok := groupIndex[root]; ok {
+			groups[idx] = append(groups[idx], m)
+		} else {
+			groupIndex[root] = len(groups)
+			groups = append(groups, []*models.Media{m})
+		}
+	}
+
+	result := make([][]*models.Media, 0)
+	for _, group := range groups {
+		if len(group) > 1 {
+			result = append(result, group)
+		}
+	}
+
+	return result
+}
+
+// stackKeys returns the keys identifying the stacks a media belongs in.
+func stackKeys(media *models.Media) []string {
+	keys := make([]string, 0, 3)
+
+	if baseName := stackBaseName(media.Path); baseName != "" {
+		keys = append(keys, "name:"+baseName)
+	}
+
+	if media.Exif != nil {
+		if media.Exif.BurstID != nil {
+			keys = append(keys, "burst:"+*media.Exif.BurstID)
+		}
+
+		// The date of files without camera is often the time they were copied, which many files share
+		if media.Type == models.MediaTypePhoto && media.Exif.Camera != nil && media.Exif.DateShot != nil {
+			keys = append(keys, "time:"+*media.Exif.Camera+"@"+media.Exif.DateShot.Format(time.RFC3339Nano))
+		}
+	}
+
+	return keys
+}
+
+var appleEditedName = regexp.MustCompile(`(?i)^(IMG_)E(\d+)$`)
+var editedNameSuffix = regexp.MustCompile(`(?i)([-_ ]edited| ?\(edited\))$`)
+
+// stackBaseName returns the name of a media file without extension and markers of edited exports,
+// like `IMG_E0001.JPG` or `photo-edited.jpg`, so an original and its edits share the same base name.
+func stackBaseName(mediaPath string) string {
+	name := path.Base(mediaPath)
+	name = strings.TrimSuffix(name, path.Ext(name))
+	name = appleEditedName.ReplaceAllString(name, "${1}${2}")
+	name = editedNameSuffix.ReplaceAllString(name, "")
+
+	return strings.ToLower(name)
+}
@@ -0,0 +1,74 @@
+package scanner_tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	_ "github.com/kkovaletp/photoview/api/test_utils/flags"
+)
+
+func TestStackBaseName(t *testing.T) {
+	tests := map[string]string{
+		"/photos/IMG_0001.JPG":       "img_0001",
+		"/photos/IMG_E0001.JPG":      "img_0001",
+		"/photos/photo-edited.jpg":   "photo",
+		"/photos/photo (edited).jpg": "photo",
+		"/photos/DSC_0001.NEF":       "dsc_0001",
+		"/photos/Meeting notes.png":  "meeting notes",
+		"/photos/IMG_EXPORT.jpg":     "img_export",
+		"/photos/credited.jpg":       "credited",
+	}
+
+	for input, want := range tests {
+		if got := stackBaseName(input); got != want {
+			t.Errorf("stackBaseName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestGroupStackCandidates(t *testing.T) {
+	shot := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	camera := "Camera"
+	burst := "burst-1"
+
+	media := []*models.Media{
+		{Model: models.Model{ID: 1}, Path: "/a/IMG_0001.JPG", Type: models.MediaTypePhoto},
+		{Model: models.Model{ID: 2}, Path: "/a/IMG_0002.JPG", Type: models.MediaTypePhoto,
+			Exif: &models.MediaEXIF{BurstID: &burst}},
+		{Model: models.Model{ID: 3}, Path: "/a/IMG_0003.JPG", Type: models.MediaTypePhoto,
+			Exif: &models.MediaEXIF{BurstID: &burst, Camera: &camera, DateShot: &shot}},
+		{Model: models.Model{ID: 4}, Path: "/a/IMG_0004.JPG", Type: models.MediaTypePhoto,
+			Exif: &models.MediaEXIF{Camera: &camera, DateShot: &shot}},
+		{Model: models.Model{ID: 5}, Path: "/a/IMG_E0001.JPG", Type: models.MediaTypePhoto},
+		{Model: models.Model{ID: 6}, Path: "/a/screenshot.png", Type: models.MediaTypePhoto,
+			Exif: &models.MediaEXIF{DateShot: &shot}},
+		{Model: models.Model{ID: 7}, Path: "/a/download.png", Type: models.MediaTypePhoto,
+			Exif: &models.MediaEXIF{DateShot: &shot}},
+	}
+
+	groups := groupStackCandidates(media)
+
+	want := [][]int{{1, 5}, {2, 3, 4}}
+	if len(groups) != len(want) {
+		t.Fatalf("groupStackCandidates() returned %d groups, want %d", len(groups), len(want))
+	}
+
+	for i, group := range groups {
+		ids := make([]int, len(group))
+		for j, m := range group {
+			ids[j] = m.ID
+		}
+
+		if len(ids) != len(want[i]) {
+			t.Errorf("group %d = %v, want %v", i, ids, want[i])
+			continue
+		}
+		for j := range ids {
+			if ids[j] != want[i][j] {
+				t.Errorf("group %d = %v, want %v", i, ids, want[i])
+				break
+			}
+		}
+	}
+}
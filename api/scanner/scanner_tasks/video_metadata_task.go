@@ -20,7 +20,7 @@ type VideoMetadataTask struct {
 
 func (t VideoMetadataTask) AfterMediaFound(ctx scanner_task.TaskContext, media *models.Media, newMedia bool) error {
 
-	if !newMedia || media.Type != models.MediaTypeVideo {
+	if (!newMedia && !media.FileChanged) || media.Type != models.MediaTypeVideo {
 		return nil
 	}
 
@@ -32,9 +32,11 @@ func (t VideoMetadataTask) AfterMediaFound(ctx scanner_task.TaskContext, media *
 	return nil
 }
 
+// ScanVideoMetadata reads the metadata of the video and saves it, replacing the saved metadata.
+// The poster frame picked by a user is kept.
 func ScanVideoMetadata(tx *gorm.DB, video *models.Media) error {
 
-	data, err := processing_tasks.ReadVideoMetadata(video.Path)
+	data, err := processing_tasks.ReadVideoMetadata(video.FilePath())
 	if err != nil {
 		return errors.Wrapf(err, "scan video metadata failed (%s)", video.Title)
 	}
@@ -74,6 +76,24 @@ func ScanVideoMetadata(tx *gorm.DB, video *models.Media) error {
 		Audio:        &audioText,
 	}
 
+	if video.VideoMetadataID != nil {
+		var saved []*models.VideoMetadata
+		if err := tx.Where("id = ?", *video.VideoMetadataID).Limit(1).Find(&saved).Error; err != nil {
+			return errors.Wrapf(err, "failed to get video metadata from database (%s)", video.Title)
+		}
+
+		// Saving the media only adds new metadata, the saved metadata is updated separately
+		if len(saved) > 0 {
+			videoMetadata.ID = saved[0].ID
+			videoMetadata.CreatedAt = saved[0].CreatedAt
+			videoMetadata.PosterTime = saved[0].PosterTime
+
+			if err := tx.Save(&videoMetadata).Error; err != nil {
+				return errors.Wrapf(err, "failed to update video metadata in database (%s)", video.Title)
+			}
+		}
+	}
+
 	video.VideoMetadata = &videoMetadata
 
 	if err := tx.Save(video).Error; err != nil {
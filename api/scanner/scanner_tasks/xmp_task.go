@@ -0,0 +1,229 @@
+package scanner_tasks
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/log"
+	"github.com/kkovaletp/photoview/api/scanner/externaltools/exif"
+	"github.com/kkovaletp/photoview/api/scanner/media_encoding"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_task"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_utils"
+)
+
+// XMPTask reads the descriptive metadata of media, like ratings and keywords, see models.MediaXMP.
+// It is read again when the XMP sidecar file of the media is added, changed or removed, or the media file changed.
+type XMPTask struct {
+	scanner_task.ScannerTaskBase
+}
+
+func (t XMPTask) AfterMediaFound(ctx scanner_task.TaskContext, media *models.Media, newMedia bool) error {
+	if !newMedia && !media.FileChanged {
+		return nil
+	}
+
+	if err := SaveXMP(ctx.GetDB(), media); err != nil {
+		log.Warn(ctx, "SaveXMP failed", "title", media.Title, "error", err, "path", media.Path)
+	}
+
+	return nil
+}
+
+func (t XMPTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData, mediaCachePath string) ([]*models.MediaURL, error) {
+	media := mediaData.Media
+
+	var saved []*models.MediaXMP
+	if err := ctx.GetDB().Where("media_id = ?", media.ID).Limit(1).Find(&saved).Error; err != nil {
+		return []*models.MediaURL{}, fmt.Errorf("failed to get XMP metadata of %q from database: %w", media.Path, err)
+	}
+
+	var savedHash *string
+	if len(saved) > 0 {
+		savedHash = saved[0].SidecarHash
+	}
+
+	var currentHash *string
+	if sidecarPath := scanner_utils.FindXMPSidecar(media.Path); sidecarPath != "" {
+		hash, err := scanner_utils.HashFile(sidecarPath)
+		if err != nil {
+			return []*models.MediaURL{}, fmt.Errorf("failed to hash XMP sidecar %q: %w", sidecarPath, err)
+		}
+		currentHash = &hash
+	}
+
+	sidecarChanged := (savedHash == nil) != (currentHash == nil) || (savedHash != nil && *savedHash != *currentHash)
+	writtenBack := len(saved) > 0 && currentHash != nil && hashEquals(saved[0].WriteBackHash, *currentHash)
+	if !sidecarChanged || writtenBack {
+		return []*models.MediaURL{}, nil
+	}
+
+	log.Info(ctx, "XMP sidecar changed, reading the metadata again", "path", media.Path)
+	if err := SaveXMP(ctx.GetDB(), media); err != nil {
+		log.Warn(ctx, "SaveXMP failed", "title", media.Title, "error", err, "path", media.Path)
+	}
+
+	return []*models.MediaURL{}, nil
+}
+
+// SaveXMP reads the descriptive metadata of the media from its file and XMP sidecar, and replaces the saved metadata.
+func SaveXMP(tx *gorm.DB, media *models.Media) error {
+	sidecarPath := scanner_utils.FindXMPSidecar(media.Path)
+
+	xmp, err := exif.ParseXMP(media.FilePath(), sidecarPath)
+	if err != nil {
+		return fmt.Errorf("failed to parse XMP metadata: %w", err)
+	}
+
+	if sidecarPath != "" {
+		hash, err := scanner_utils.HashFile(sidecarPath)
+		if err != nil {
+			return fmt.Errorf("failed to hash XMP sidecar %q: %w", sidecarPath, err)
+		}
+
+		xmp.SidecarPath = &sidecarPath
+		xmp.SidecarHash = &hash
+	}
+
+	return tx.Transaction(func(tx *gorm.DB) error {
+		var old []*models.MediaXMP
+		if err := tx.Where("media_id = ?", media.ID).Limit(1).Find(&old).Error; err != nil {
+			return fmt.Errorf("failed to get old XMP metadata of %q: %w", media.Path, err)
+		}
+
+		// The hash of the last write-back is kept, see WriteBackXMP
+		if len(old) > 0 {
+			xmp.WriteBackHash = old[0].WriteBackHash
+		}
+
+		if err := tx.Where("media_id = ?", media.ID).Delete(&models.MediaXMP{}).Error; err != nil {
+			return fmt.Errorf("failed to delete old XMP metadata of %q: %w", media.Path, err)
+		}
+
+		// The sidecar is saved even without metadata, so it isn't read again until it changes
+		if xmp.IsEmpty() && xmp.SidecarPath == nil {
+			return nil
+		}
+
+		xmp.MediaID = media.ID
+		if err := tx.Omit("Media").Create(xmp).Error; err != nil {
+			return fmt.Errorf("failed to save XMP metadata of %q: %w", media.Path, err)
+		}
+
+		ownerIDs, err := mediaOwnerIDs(tx, media)
+		if err != nil {
+			return fmt.Errorf("failed to get owners of %q: %w", media.Path, err)
+		}
+
+		if xmp.Rating != nil {
+			if err := seedUserRatings(tx, media, ownerIDs, *xmp.Rating); err != nil {
+				return fmt.Errorf("failed to seed ratings of %q: %w", media.Path, err)
+			}
+		}
+
+		if err := seedUserTags(tx, media, ownerIDs, xmpTagPaths(xmp)); err != nil {
+			return fmt.Errorf("failed to seed tags of %q: %w", media.Path, err)
+		}
+
+		return nil
+	})
+}
+
+// mediaOwnerIDs returns the ids of the users owning the album of the media.
+func mediaOwnerIDs(tx *gorm.DB, media *models.Media) ([]int, error) {
+	var ownerIDs []int
+	if err := tx.Table("user_albums").Where("album_id = ?", media.AlbumID).Pluck("user_id", &ownerIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return ownerIDs, nil
+}
+
+// seedUserRatings gives the owners `ownerIDs` of the media the rating read from its XMP metadata, where -1 rejects the media.
+// Owners who already rated or rejected the media keep their values.
+func seedUserRatings(tx *gorm.DB, media *models.Media, ownerIDs []int, rating int) error {
+	if len(ownerIDs) == 0 {
+		return nil
+	}
+
+	rejected := rating < 0
+	rating = max(rating, models.MinRating)
+
+	if err := tx.Model(&models.UserMediaData{}).
+		Where("media_id = ? AND user_id IN (?)", media.ID, ownerIDs).
+		Where("rating = ? AND rejected = ?", 0, false).
+		Updates(map[string]any{"rating": rating, "rejected": rejected}).Error; err != nil {
+		return err
+	}
+
+	userMediaData := make([]*models.UserMediaData, len(ownerIDs))
+	for i, userID := range ownerIDs {
+		userMediaData[i] = &models.UserMediaData{
+			UserID:   userID,
+			MediaID:  media.ID,
+			Rating:   rating,
+			Rejected: rejected,
+		}
+	}
+
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&userMediaData).Error
+}
+
+// seedUserTags tags the media with `tagPaths` for all of its owners `ownerIDs`, creating the tags they don't have yet.
+// Tags the owners removed from the media before are given again, as the keywords of its metadata changed since.
+func seedUserTags(tx *gorm.DB, media *models.Media, ownerIDs []int, tagPaths []string) error {
+	if len(ownerIDs) == 0 || len(tagPaths) == 0 {
+		return nil
+	}
+
+	mediaTags := make([]*models.MediaTag, 0, len(ownerIDs)*len(tagPaths))
+	for _, userID := range ownerIDs {
+		for _, tagPath := range tagPaths {
+			tag, err := models.FindOrCreateTag(tx, userID, tagPath)
+			if err != nil {
+				return err
+			}
+
+			mediaTags = append(mediaTags, &models.MediaTag{TagID: tag.ID, MediaID: media.ID})
+		}
+	}
+
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&mediaTags).Error
+}
+
+// xmpTagPaths returns the paths of the tags for the keywords of the XMP metadata.
+// The levels of hierarchical keywords become nested tags, and flat keywords which are also the level
+// of a hierarchical keyword, as written by Lightroom, are left out. Keywords which aren't valid tags,
+// like ones containing line breaks, are skipped.
+func xmpTagPaths(xmp *models.MediaXMP) []string {
+	var tagPaths []string
+	levels := make(map[string]bool)
+
+	for _, subject := range xmp.HierarchicalSubjects {
+		subjectLevels := strings.Split(subject, "|")
+		if tagPath, err := models.NormalizeTagPath(models.TagPath(subjectLevels)); err == nil {
+			tagPaths = append(tagPaths, tagPath)
+		}
+
+		for _, level := range subjectLevels {
+			levels[strings.TrimSpace(level)] = true
+		}
+	}
+
+	for _, keyword := range xmp.Keywords {
+		if levels[strings.TrimSpace(keyword)] {
+			continue
+		}
+
+		if tagPath, err := models.NormalizeTagPath(models.TagPath([]string{keyword})); err == nil {
+			tagPaths = append(tagPaths, tagPath)
+		}
+	}
+
+	slices.Sort(tagPaths)
+	return slices.Compact(tagPaths)
+}
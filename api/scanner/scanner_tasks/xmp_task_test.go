@@ -0,0 +1,20 @@
+package scanner_tasks
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+)
+
+func TestXMPTagPaths(t *testing.T) {
+	xmp := &models.MediaXMP{
+		Keywords:             []string{"Places", "Italy", "Rome", "Sunset", "AC/DC", " ", "Sky\n-XMP-dc:Rights=x"},
+		HierarchicalSubjects: []string{"Places|Italy|Rome", "Places|Italy", "Music| AC/DC", "Places|Paris\r\n-all="},
+	}
+
+	want := []string{"Music/AC-DC", "Places/Italy", "Places/Italy/Rome", "Sunset"}
+	if got := xmpTagPaths(xmp); !slices.Equal(got, want) {
+		t.Errorf("xmpTagPaths() = %v, want %v", got, want)
+	}
+}
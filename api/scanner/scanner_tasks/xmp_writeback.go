@@ -0,0 +1,256 @@
+package scanner_tasks
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+
+	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner/externaltools/exif"
+	"github.com/kkovaletp/photoview/api/scanner/media_archive"
+	"github.com/kkovaletp/photoview/api/scanner/scanner_utils"
+	"github.com/kkovaletp/photoview/api/utils"
+)
+
+// XMPWriteBackEnabled reports whether the curation done in Photoview is written back to XMP sidecar files,
+// which is turned on by PHOTOVIEW_XMP_WRITE_BACK.
+func XMPWriteBackEnabled() bool {
+	return utils.EnvXMPWriteBack.GetBool()
+}
+
+// xmpWriteBackPath returns the XMP sidecar file the curation of the media at `mediaPath` is written to,
+// and whether it is next to the media, where the scanner reads it again.
+// If PHOTOVIEW_XMP_WRITE_BACK_MIRROR_PATH is set, for media on read-only mounts, the sidecar is written below that directory
+// at the path of the media instead. Without a mirror directory, an empty path is returned for media inside archives.
+func xmpWriteBackPath(mediaPath string) (sidecarPath string, inPlace bool) {
+	if mirrorPath := utils.EnvXMPWriteBackMirrorPath.GetValue(); mirrorPath != "" {
+		return filepath.Join(mirrorPath, mediaPath+".xmp"), false
+	}
+
+	if _, _, ok := media_archive.Split(mediaPath); ok {
+		return "", false
+	}
+
+	if sidecarPath := scanner_utils.FindXMPSidecar(mediaPath); sidecarPath != "" {
+		return sidecarPath, true
+	}
+
+	return mediaPath + ".xmp", true
+}
+
+// XMPRemoved is curation removed from media in Photoview, which is also removed from their XMP sidecars.
+// Other values of a sidecar are only added to, so values Photoview doesn't know about, like keywords of media
+// scanned before they became tags, are kept.
+type XMPRemoved struct {
+	// Rating is true if a rating was cleared or a media is not rejected anymore
+	Rating bool
+	// TagPaths are the paths of tags removed from the media
+	TagPaths []string
+}
+
+// xmpCuration is the curation of a media done in Photoview, which is written to its XMP sidecar.
+type xmpCuration struct {
+	// Favorite is true if any user marked the media as favorite, it is written as `xmpDM:Good`
+	Favorite bool
+	// Rating is the highest star rating given by a user, or -1 if the media was rejected and not rated by anyone.
+	// It is nil if no user rated the media.
+	Rating *int
+	// TagPaths are the paths of the tags any user gave the media, they are written as `dc:Subject`,
+	// and as `lr:HierarchicalSubject` with levels separated by `|` for tags below another tag
+	TagPaths []string
+}
+
+func loadXMPCuration(db *gorm.DB, mediaID int) (xmpCuration, error) {
+	var userMediaData []*models.UserMediaData
+	if err := db.Where("media_id = ?", mediaID).Find(&userMediaData).Error; err != nil {
+		return xmpCuration{}, fmt.Errorf("failed to get user data of media %d: %w", mediaID, err)
+	}
+
+	var curation xmpCuration
+	for _, data := range userMediaData {
+		curation.Favorite = curation.Favorite || data.Favorite
+
+		rating := data.Rating
+		if data.Rejected && rating == 0 {
+			rating = -1
+		} else if rating == 0 {
+			continue
+		}
+
+		if curation.Rating == nil || rating > *curation.Rating {
+			curation.Rating = &rating
+		}
+	}
+
+	if err := db.Model(&models.Tag{}).
+		Joins("JOIN media_tags ON media_tags.tag_id = tags.id").
+		Where("media_tags.media_id = ?", mediaID).
+		Distinct().Order("tags.path").
+		Pluck("tags.path", &curation.TagPaths).Error; err != nil {
+		return xmpCuration{}, fmt.Errorf("failed to get tags of media %d: %w", mediaID, err)
+	}
+
+	return curation, nil
+}
+
+func (c xmpCuration) isEmpty() bool {
+	return !c.Favorite && c.Rating == nil && len(c.TagPaths) == 0
+}
+
+// tags returns the exiftool assignments writing the curation, and removing the `removed` curation no user has anymore.
+// exiftool reads every assignment as a line, so tags containing control characters like line breaks are rejected.
+func (c xmpCuration) tags(removed XMPRemoved) ([]string, error) {
+	for _, tagPath := range slices.Concat(c.TagPaths, removed.TagPaths) {
+		if strings.IndexFunc(tagPath, unicode.IsControl) >= 0 {
+			return nil, fmt.Errorf("tag %q contains control characters", tagPath)
+		}
+	}
+
+	good := ""
+	if c.Favorite {
+		good = "True"
+	}
+
+	tags := []string{"-XMP-xmpDM:Good=" + good}
+
+	if c.Rating != nil {
+		tags = append(tags, "-XMP-xmp:Rating="+strconv.Itoa(*c.Rating))
+	} else if removed.Rating {
+		tags = append(tags, "-XMP-xmp:Rating=")
+	}
+
+	subjects := make(map[string]bool, len(c.TagPaths))
+	for _, tagPath := range c.TagPaths {
+		subjects[path.Base(tagPath)] = true
+	}
+
+	for _, tagPath := range removed.TagPaths {
+		if slices.Contains(c.TagPaths, tagPath) {
+			continue
+		}
+
+		if hierarchical := hierarchicalSubject(tagPath); hierarchical != "" {
+			tags = append(tags, "-XMP-lr:HierarchicalSubject-="+hierarchical)
+		}
+
+		if subject := path.Base(tagPath); !subjects[subject] {
+			tags = append(tags, "-XMP-dc:Subject-="+subject)
+		}
+	}
+
+	// Values are removed before they are added, so they aren't added twice
+	for _, tagPath := range c.TagPaths {
+		subject := path.Base(tagPath)
+		tags = append(tags, "-XMP-dc:Subject-="+subject, "-XMP-dc:Subject+="+subject)
+
+		if hierarchical := hierarchicalSubject(tagPath); hierarchical != "" {
+			tags = append(tags, "-XMP-lr:HierarchicalSubject-="+hierarchical, "-XMP-lr:HierarchicalSubject+="+hierarchical)
+		}
+	}
+
+	return tags, nil
+}
+
+// hierarchicalSubject returns the `lr:HierarchicalSubject` of a tag below another tag, or an empty string for top-level tags.
+func hierarchicalSubject(tagPath string) string {
+	if !strings.Contains(tagPath, models.TagSeparator) {
+		return ""
+	}
+
+	return strings.ReplaceAll(tagPath, models.TagSeparator, "|")
+}
+
+// WriteBackXMP writes the curation of the media done in Photoview, like favorites, ratings and tags, to its XMP sidecar file
+// if PHOTOVIEW_XMP_WRITE_BACK is enabled, and removes the `removed` curation. The sidecar is created if it doesn't exist yet.
+//
+// A sidecar next to the media is scanned again, so its hash after writing is saved as the WriteBackHash of the media,
+// which keeps the SidecarTask from rendering the media again and the XMPTask from reading it again
+// for a change made by Photoview itself.
+// The hash isn't saved if another program changed the sidecar since it was last read, so that change is still rendered.
+func WriteBackXMP(db *gorm.DB, media *models.Media, removed XMPRemoved) error {
+	if !XMPWriteBackEnabled() {
+		return nil
+	}
+
+	sidecarPath, inPlace := xmpWriteBackPath(media.Path)
+	if sidecarPath == "" {
+		return nil
+	}
+
+	curation, err := loadXMPCuration(db, media.ID)
+	if err != nil {
+		return err
+	}
+
+	sidecarExists := scanner_utils.FileExists(sidecarPath)
+	if !sidecarExists && curation.isEmpty() {
+		return nil
+	}
+
+	tags, err := curation.tags(removed)
+	if err != nil {
+		return fmt.Errorf("failed to write XMP sidecar %q: %w", sidecarPath, err)
+	}
+
+	if !inPlace {
+		if err := os.MkdirAll(filepath.Dir(sidecarPath), os.ModePerm); err != nil {
+			return fmt.Errorf("failed to create directory of XMP sidecar %q: %w", sidecarPath, err)
+		}
+
+		if err := exif.WriteTags(sidecarPath, tags...); err != nil {
+			return fmt.Errorf("failed to write XMP sidecar %q: %w", sidecarPath, err)
+		}
+
+		return nil
+	}
+
+	var saved []*models.MediaXMP
+	if err := db.Where("media_id = ?", media.ID).Limit(1).Find(&saved).Error; err != nil {
+		return fmt.Errorf("failed to get XMP metadata of %q from database: %w", media.Path, err)
+	}
+
+	xmp := &models.MediaXMP{MediaID: media.ID}
+	if len(saved) > 0 {
+		xmp = saved[0]
+	}
+
+	changedElsewhere := false
+	if sidecarExists {
+		hash, err := scanner_utils.HashFile(sidecarPath)
+		if err != nil {
+			return fmt.Errorf("failed to hash XMP sidecar %q: %w", sidecarPath, err)
+		}
+
+		changedElsewhere = !hashEquals(xmp.SidecarHash, hash) && !hashEquals(xmp.WriteBackHash, hash)
+	}
+
+	if err := exif.WriteTags(sidecarPath, tags...); err != nil {
+		return fmt.Errorf("failed to write XMP sidecar %q: %w", sidecarPath, err)
+	}
+
+	xmp.WriteBackHash = nil
+	if !changedElsewhere {
+		hash, err := scanner_utils.HashFile(sidecarPath)
+		if err != nil {
+			return fmt.Errorf("failed to hash XMP sidecar %q: %w", sidecarPath, err)
+		}
+		xmp.WriteBackHash = &hash
+	}
+
+	if err := db.Omit("Media").Save(xmp).Error; err != nil {
+		return fmt.Errorf("failed to save XMP write-back hash of %q: %w", media.Path, err)
+	}
+
+	return nil
+}
+
+func hashEquals(savedHash *string, hash string) bool {
+	return savedHash != nil && *savedHash == hash
+}
@@ -0,0 +1,84 @@
+package scanner_tasks
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/kkovaletp/photoview/api/utils"
+)
+
+func TestXMPWriteBackPath(t *testing.T) {
+	dir := t.TempDir()
+	mediaPath := filepath.Join(dir, "IMG_0001.CR2")
+
+	if got, inPlace := xmpWriteBackPath(mediaPath); got != mediaPath+".xmp" || !inPlace {
+		t.Errorf("new sidecar: got %q (in place %v), want %q", got, inPlace, mediaPath+".xmp")
+	}
+
+	lightroomSidecar := filepath.Join(dir, "IMG_0001.xmp")
+	if err := os.WriteFile(lightroomSidecar, []byte{}, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, _ := xmpWriteBackPath(mediaPath); got != lightroomSidecar {
+		t.Errorf("existing sidecar: got %q, want %q", got, lightroomSidecar)
+	}
+
+	t.Setenv(string(utils.EnvXMPWriteBackMirrorPath), "/mirror")
+	want := filepath.Join("/mirror", mediaPath+".xmp")
+	if got, inPlace := xmpWriteBackPath(mediaPath); got != want || inPlace {
+		t.Errorf("mirror: got %q (in place %v), want %q", got, inPlace, want)
+	}
+}
+
+func TestXMPCurationTags(t *testing.T) {
+	rejected := -1
+	tests := []struct {
+		name     string
+		curation xmpCuration
+		removed  XMPRemoved
+		want     []string
+	}{
+		{"favorite", xmpCuration{Favorite: true}, XMPRemoved{}, []string{"-XMP-xmpDM:Good=True"}},
+		{"cleared rating", xmpCuration{}, XMPRemoved{Rating: true}, []string{"-XMP-xmpDM:Good=", "-XMP-xmp:Rating="}},
+		{"rejected", xmpCuration{Rating: &rejected}, XMPRemoved{Rating: true}, []string{"-XMP-xmpDM:Good=", "-XMP-xmp:Rating=-1"}},
+		{
+			"tags",
+			xmpCuration{TagPaths: []string{"Places/Italy/Rome", "Rome"}},
+			XMPRemoved{TagPaths: []string{"Places/France/Paris", "Places/Italy/Rome", "Travel/Rome"}},
+			[]string{
+				"-XMP-xmpDM:Good=",
+				"-XMP-lr:HierarchicalSubject-=Places|France|Paris",
+				"-XMP-dc:Subject-=Paris",
+				"-XMP-lr:HierarchicalSubject-=Travel|Rome",
+				"-XMP-dc:Subject-=Rome", "-XMP-dc:Subject+=Rome",
+				"-XMP-lr:HierarchicalSubject-=Places|Italy|Rome", "-XMP-lr:HierarchicalSubject+=Places|Italy|Rome",
+				"-XMP-dc:Subject-=Rome", "-XMP-dc:Subject+=Rome",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.curation.tags(test.removed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	for _, tagPath := range []string{"Sky\n-XMP-dc:Rights=x", "Places/Paris\r"} {
+		if _, err := (xmpCuration{TagPaths: []string{tagPath}}).tags(XMPRemoved{}); err == nil {
+			t.Errorf("tag %q: expected an error", tagPath)
+		}
+
+		if _, err := (xmpCuration{}).tags(XMPRemoved{TagPaths: []string{tagPath}}); err == nil {
+			t.Errorf("removed tag %q: expected an error", tagPath)
+		}
+	}
+}
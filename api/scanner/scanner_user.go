@@ -8,6 +8,7 @@ import (
 	"path"
 
 	"github.com/kkovaletp/photoview/api/graphql/models"
+	"github.com/kkovaletp/photoview/api/scanner/media_archive"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_cache"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_tasks/cleanup_tasks"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_utils"
@@ -68,6 +69,10 @@ func FindAlbumsForUser(db *gorm.DB, user *models.User, albumCache *scanner_cache
 		path   string
 		parent *models.Album
 		ignore []string
+		// scanArchives is set when the root album lets ZIP archives be scanned as albums
+		scanArchives bool
+		// archive is set when the album is made from an archive instead of a directory
+		archive bool
 	}
 
 	scanQueue := list.New()
@@ -82,9 +87,10 @@ func FindAlbumsForUser(db *gorm.DB, user *models.User, albumCache *scanner_cache
 			}
 		} else {
 			scanQueue.PushBack(scanInfo{
-				path:   album.Path,
-				parent: nil,
-				ignore: nil,
+				path:         album.Path,
+				parent:       nil,
+				ignore:       nil,
+				scanArchives: album.ScanArchives,
 			})
 		}
 	}
@@ -99,26 +105,32 @@ func FindAlbumsForUser(db *gorm.DB, user *models.User, albumCache *scanner_cache
 		albumParent := albumInfo.parent
 		albumIgnore := albumInfo.ignore
 
-		// Read path
-		dirContent, err := os.ReadDir(albumPath)
-		if err != nil {
-			scanErrors = append(scanErrors, errors.Wrapf(err, "read directory (%s)", albumPath))
-			continue
-		}
+		var dirContent []os.DirEntry
 
-		// Skip this dir if in ignore list
-		ignorePaths := ignore.CompileIgnoreLines(albumIgnore...)
-		if ignorePaths.MatchesPath(albumPath + "/") {
-			log.Printf("Skip, directroy %s is in ignore file", albumPath)
-			continue
-		}
+		// Archives have no sub-albums and no ignore file, they were matched against the ignore list when found
+		if !albumInfo.archive {
+			// Read path
+			entries, err := os.ReadDir(albumPath)
+			if err != nil {
+				scanErrors = append(scanErrors, errors.Wrapf(err, "read directory (%s)", albumPath))
+				continue
+			}
+			dirContent = entries
 
-		// Update ignore dir list
-		photoviewIgnore, err := getPhotoviewIgnore(albumPath)
-		if err != nil {
-			log.Printf("Failed to get ignore file, err = %s", err)
-		} else {
-			albumIgnore = append(albumIgnore, photoviewIgnore...)
+			// Skip this dir if in ignore list
+			ignorePaths := ignore.CompileIgnoreLines(albumIgnore...)
+			if ignorePaths.MatchesPath(albumPath + "/") {
+				log.Printf("Skip, directroy %s is in ignore file", albumPath)
+				continue
+			}
+
+			// Update ignore dir list
+			photoviewIgnore, err := getPhotoviewIgnore(albumPath)
+			if err != nil {
+				log.Printf("Failed to get ignore file, err = %s", err)
+			} else {
+				albumIgnore = append(albumIgnore, photoviewIgnore...)
+			}
 		}
 
 		// Will become new album or album from db
@@ -209,11 +221,22 @@ func FindAlbumsForUser(db *gorm.DB, user *models.User, albumCache *scanner_cache
 				continue
 			}
 
-			if (item.IsDir() || isDirSymlink) && directoryContainsPhotos(subalbumPath, albumCache, albumIgnore) {
+			if (item.IsDir() || isDirSymlink) && directoryContainsPhotos(subalbumPath, albumCache, albumIgnore, albumInfo.scanArchives) {
+				scanQueue.PushBack(scanInfo{
+					path:         subalbumPath,
+					parent:       album,
+					ignore:       albumIgnore,
+					scanArchives: albumInfo.scanArchives,
+				})
+			} else if albumInfo.scanArchives && !item.IsDir() && media_archive.IsArchive(subalbumPath) &&
+				!ignore.CompileIgnoreLines(albumIgnore...).MatchesPath(item.Name()) &&
+				archiveContainsMedia(subalbumPath, albumCache) {
 				scanQueue.PushBack(scanInfo{
-					path:   subalbumPath,
-					parent: album,
-					ignore: albumIgnore,
+					path:         subalbumPath,
+					parent:       album,
+					ignore:       albumIgnore,
+					scanArchives: true,
+					archive:      true,
 				})
 			}
 		}
@@ -225,7 +248,7 @@ func FindAlbumsForUser(db *gorm.DB, user *models.User, albumCache *scanner_cache
 	return userAlbums, scanErrors
 }
 
-func directoryContainsPhotos(rootPath string, cache *scanner_cache.AlbumScannerCache, albumIgnore []string) bool {
+func directoryContainsPhotos(rootPath string, cache *scanner_cache.AlbumScannerCache, albumIgnore []string, scanArchives bool) bool {
 
 	if containsImage := cache.AlbumContainsPhotos(rootPath); containsImage != nil {
 		return *containsImage
@@ -269,6 +292,12 @@ func directoryContainsPhotos(rootPath string, cache *scanner_cache.AlbumScannerC
 
 			if fileInfo.IsDir() || isDirSymlink {
 				scanQueue.PushBack(filePath)
+			} else if scanArchives && media_archive.IsArchive(filePath) {
+				if !ignoreEntries.MatchesPath(fileInfo.Name()) && archiveContainsMedia(filePath, cache) {
+					log.Printf("Insert Album %s %s, contains archive with photo", dirPath, rootPath)
+					cache.InsertAlbumPaths(dirPath, rootPath, true)
+					return true
+				}
 			} else {
 				if cache.IsPathMedia(filePath) {
 					if ignoreEntries.MatchesPath(fileInfo.Name()) {
@@ -290,3 +319,27 @@ func directoryContainsPhotos(rootPath string, cache *scanner_cache.AlbumScannerC
 	}
 	return false
 }
+
+// archiveContainsMedia tells if the archive at `archivePath` contains any media, like directoryContainsPhotos.
+func archiveContainsMedia(archivePath string, cache *scanner_cache.AlbumScannerCache) bool {
+	if containsMedia := cache.AlbumContainsPhotos(archivePath); containsMedia != nil {
+		return *containsMedia
+	}
+
+	entries, err := media_archive.Entries(archivePath)
+	if err != nil {
+		scanner_utils.ScannerError(nil, "Could not read archive (%s): %s\n", archivePath, err.Error())
+		return false
+	}
+
+	for _, entry := range entries {
+		cache.InsertFileInfo(entry.Path, entry.Info)
+		if cache.IsPathMedia(entry.Path) {
+			cache.InsertAlbumPath(archivePath, true)
+			return true
+		}
+	}
+
+	cache.InsertAlbumPath(archivePath, false)
+	return false
+}
@@ -1,8 +1,13 @@
 package scanner_utils
 
 import (
+	"crypto/md5"
+	"encoding/hex"
+	"io"
 	"log"
 	"os"
+	"path"
+	"strings"
 )
 
 func FileExists(testPath string) bool {
@@ -17,3 +22,35 @@ func FileExists(testPath string) bool {
 	}
 	return true
 }
+
+// FindXMPSidecar returns the path of the XMP sidecar file of the media at `mediaPath`, or an empty string if it has none.
+// Both `photo.ext.xmp`, as written by darktable, and `photo.xmp`, as written by Lightroom, are found.
+func FindXMPSidecar(mediaPath string) string {
+	base := strings.TrimSuffix(mediaPath, path.Ext(mediaPath))
+
+	for _, candidate := range []string{mediaPath, base} {
+		for _, ext := range []string{".xmp", ".XMP"} {
+			if FileExists(candidate + ext) {
+				return candidate + ext
+			}
+		}
+	}
+
+	return ""
+}
+
+// HashFile returns the MD5 hash of the content of the file at `filePath`, used to detect changes of sidecar files.
+func HashFile(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
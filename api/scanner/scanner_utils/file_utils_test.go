@@ -0,0 +1,56 @@
+package scanner_utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindXMPSidecar(t *testing.T) {
+	dir := t.TempDir()
+	mediaPath := filepath.Join(dir, "photo.cr2")
+
+	write := func(t *testing.T, name string) string {
+		t.Helper()
+
+		filePath := filepath.Join(dir, name)
+		if err := os.WriteFile(filePath, []byte(name), 0644); err != nil {
+			t.Fatalf("os.WriteFile() error: %v", err)
+		}
+		return filePath
+	}
+
+	if got := FindXMPSidecar(mediaPath); got != "" {
+		t.Errorf("FindXMPSidecar() without sidecar = %q, want an empty string", got)
+	}
+
+	lightroomSidecar := write(t, "photo.xmp")
+	if got := FindXMPSidecar(mediaPath); got != lightroomSidecar {
+		t.Errorf("FindXMPSidecar() = %q, want the sidecar of Lightroom %q", got, lightroomSidecar)
+	}
+
+	darktableSidecar := write(t, "photo.cr2.xmp")
+	if got := FindXMPSidecar(mediaPath); got != darktableSidecar {
+		t.Errorf("FindXMPSidecar() = %q, want the sidecar of darktable %q first", got, darktableSidecar)
+	}
+}
+
+func TestHashFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "photo.xmp")
+	if err := os.WriteFile(filePath, []byte("xmp"), 0644); err != nil {
+		t.Fatalf("os.WriteFile() error: %v", err)
+	}
+
+	hash, err := HashFile(filePath)
+	if err != nil {
+		t.Fatalf("HashFile() error: %v", err)
+	}
+
+	if want := "bfae74dd35a05ffe9addd44269e75dfb"; hash != want {
+		t.Errorf("HashFile() = %q, want %q", hash, want)
+	}
+
+	if _, err := HashFile(filePath + ".missing"); err == nil {
+		t.Errorf("HashFile() of a missing file doesn't return an error")
+	}
+}
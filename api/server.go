@@ -23,16 +23,28 @@ import (
 	"github.com/kkovaletp/photoview/api/routes"
 	"github.com/kkovaletp/photoview/api/scanner/externaltools/exif"
 	"github.com/kkovaletp/photoview/api/scanner/face_detection"
+	"github.com/kkovaletp/photoview/api/scanner/media_cache"
 	"github.com/kkovaletp/photoview/api/scanner/media_encoding/executable_worker"
 	"github.com/kkovaletp/photoview/api/scanner/periodic_scanner"
 	"github.com/kkovaletp/photoview/api/scanner/scanner_queue"
 	"github.com/kkovaletp/photoview/api/server"
+	"github.com/kkovaletp/photoview/api/storage"
 	"github.com/kkovaletp/photoview/api/utils"
 
 	"github.com/99designs/gqlgen/graphql/playground"
 )
 
 func main() {
+	// Image worker processes are started with the same executable by the server
+	if executable_worker.IsImageWorkerProcess() {
+		executable_worker.RunImageWorkerProcess()
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == checkCacheCommand {
+		os.Exit(runCheckCacheCommand(os.Args[2:]))
+	}
+
 	log.Println("Starting Photoview...")
 
 	if err := godotenv.Load(); err != nil {
@@ -60,6 +72,10 @@ func main() {
 	}
 	defer exifCleanup()
 
+	if err := storage.Initialize(); err != nil {
+		log.Panicf("Could not initialize media storage: %s\n", err)
+	}
+
 	if err := scanner_queue.InitializeScannerQueue(db); err != nil {
 		log.Panicf("Could not initialize scanner queue: %s\n", err)
 	}
@@ -68,6 +84,8 @@ func main() {
 		log.Panicf("Could not initialize periodic scanner: %s", err)
 	}
 
+	media_cache.InitializeMediaCacheEviction(db)
+
 	if err := face_detection.InitializeFaceDetector(db); err != nil {
 		log.Panicf("Could not initialize face detector: %s\n", err)
 	}
@@ -153,6 +171,7 @@ func setupGracefulShutdown(svr *http.Server) {
 		// Shutdown scanners in correct order
 		periodic_scanner.ShutdownPeriodicScanner()
 		scanner_queue.CloseScannerQueue()
+		media_cache.ShutdownMediaCacheEviction()
 
 		if err := svr.Shutdown(ctx); err != nil {
 			log.Printf("Server shutdown error: %s", err)
@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/utils"
+	"github.com/pkg/errors"
+)
+
+// localStorage keeps the media cache in a directory of the local disk.
+type localStorage struct {
+	root string
+}
+
+// NewLocal returns a storage keeping the files in the directory `root`, or in the media cache path if it is empty.
+func NewLocal(root string) Storage {
+	return &localStorage{root: root}
+}
+
+func (s *localStorage) rootPath() string {
+	if s.root != "" {
+		return s.root
+	}
+	return utils.MediaCachePath()
+}
+
+func (s *localStorage) LocalPath(key string) string {
+	return filepath.Join(s.rootPath(), filepath.FromSlash(key))
+}
+
+func (s *localStorage) Stat(ctx context.Context, key string) (*Object, error) {
+	info, err := os.Stat(s.LocalPath(key))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Object{Key: key, Size: info.Size(), ModTime: info.ModTime()}, nil
+}
+
+func (s *localStorage) Open(ctx context.Context, key string) (io.ReadSeekCloser, *Object, error) {
+	file, err := os.Open(s.LocalPath(key))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+
+	return file, &Object{Key: key, Size: info.Size(), ModTime: info.ModTime()}, nil
+}
+
+func (s *localStorage) Put(ctx context.Context, key string, localPath string, contentType string) error {
+	destination := s.LocalPath(key)
+	if filepath.Clean(localPath) == destination {
+		// Generated in place by WorkDir
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destination), os.ModePerm); err != nil {
+		return errors.Wrapf(err, "create directory of %s", key)
+	}
+
+	return copyFile(localPath, destination)
+}
+
+func (s *localStorage) Fetch(ctx context.Context, key string, localPath string) error {
+	source := s.LocalPath(key)
+	if filepath.Clean(localPath) == source {
+		return nil
+	}
+
+	return copyFile(source, localPath)
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	if err := os.Remove(s.LocalPath(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *localStorage) DeletePrefix(ctx context.Context, prefix string) error {
+	prefixPath := s.LocalPath(prefix)
+	if err := os.RemoveAll(prefixPath); err != nil {
+		return err
+	}
+
+	// Remove the album directory too once its last media directory is removed, ignoring the error if it's not empty
+	if parent := filepath.Dir(prefixPath); parent != filepath.Clean(s.rootPath()) {
+		_ = os.Remove(parent)
+	}
+
+	return nil
+}
+
+func (s *localStorage) List(ctx context.Context, prefix string) ([]Object, error) {
+	root := s.rootPath()
+	prefixPath := s.LocalPath(prefix)
+	objects := make([]Object, 0)
+
+	err := filepath.WalkDir(prefixPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if filePath == prefixPath && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			// The file was removed in the meantime
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		relativePath, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+
+		objects = append(objects, Object{Key: filepath.ToSlash(relativePath), Size: info.Size(), ModTime: info.ModTime()})
+		return nil
+	})
+
+	return objects, err
+}
+
+func (s *localStorage) PresignedURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	return "", nil
+}
+
+// copyFile copies `source` to `destination` through a temporary file, so a partially copied file is never visible.
+func copyFile(source string, destination string) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.CreateTemp(filepath.Dir(destination), ".copy-*")
+	if err != nil {
+		return errors.Wrap(err, "create temporary file")
+	}
+	defer os.Remove(out.Name())
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return errors.Wrapf(err, "copy %s", source)
+	}
+
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(out.Name(), destination)
+}
@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/utils"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+)
+
+// S3Config is the connection to an S3-compatible bucket the media cache is stored in.
+type S3Config struct {
+	Endpoint   string
+	Region     string
+	Bucket     string
+	AccessKey  string
+	SecretKey  string
+	DisableTLS bool
+	// Prefix is prepended to the keys of all files, to share a bucket with other data
+	Prefix string
+	// PresignedURLs lets clients download the files directly from the bucket, instead of through the API
+	PresignedURLs bool
+}
+
+// S3ConfigFromEnv reads the configuration of the S3 storage from the PHOTOVIEW_S3_* environment variables.
+func S3ConfigFromEnv() S3Config {
+	return S3Config{
+		Endpoint:      utils.EnvS3Endpoint.GetValue(),
+		Region:        utils.EnvS3Region.GetValue(),
+		Bucket:        utils.EnvS3Bucket.GetValue(),
+		AccessKey:     utils.EnvS3AccessKey.GetValue(),
+		SecretKey:     utils.EnvS3SecretKey.GetValue(),
+		DisableTLS:    utils.EnvS3DisableTLS.GetBool(),
+		Prefix:        utils.EnvS3Prefix.GetValue(),
+		PresignedURLs: utils.EnvS3PresignedURLs.GetBool(),
+	}
+}
+
+// s3Storage keeps the media cache in a bucket of an S3-compatible object storage.
+type s3Storage struct {
+	client        *minio.Client
+	bucket        string
+	prefix        string
+	presignedURLs bool
+}
+
+// NewS3 connects to the bucket of `config`, which must exist.
+func NewS3(ctx context.Context, config S3Config) (Storage, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, errors.Errorf("%s and %s must be set to store the media cache in S3",
+			utils.EnvS3Endpoint.GetName(), utils.EnvS3Bucket.GetName())
+	}
+
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure: !config.DisableTLS,
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "create S3 client")
+	}
+
+	exists, err := client.BucketExists(ctx, config.Bucket)
+	if err != nil {
+		return nil, errors.Wrapf(err, "check S3 bucket %q", config.Bucket)
+	}
+	if !exists {
+		return nil, errors.Errorf("S3 bucket %q does not exist", config.Bucket)
+	}
+
+	return &s3Storage{
+		client:        client,
+		bucket:        config.Bucket,
+		prefix:        strings.Trim(config.Prefix, "/"),
+		presignedURLs: config.PresignedURLs,
+	}, nil
+}
+
+// objectName returns the name in the bucket of the file `key`.
+func (s *s3Storage) objectName(key string) string {
+	return path.Join(s.prefix, key)
+}
+
+// listPrefix returns the prefix of the names in the bucket of all files under `prefix`.
+func (s *s3Storage) listPrefix(prefix string) string {
+	if name := s.objectName(prefix); name != "" {
+		return name + "/"
+	}
+	return ""
+}
+
+// translateError makes errors for missing objects satisfy `errors.Is(err, fs.ErrNotExist)`.
+func translateError(err error, key string) error {
+	if err == nil {
+		return nil
+	}
+
+	if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+		return errors.Wrapf(fs.ErrNotExist, "%s not found in S3 bucket", key)
+	}
+
+	return errors.Wrapf(err, "S3 request for %s", key)
+}
+
+func (s *s3Storage) LocalPath(key string) string {
+	return ""
+}
+
+func (s *s3Storage) Stat(ctx context.Context, key string) (*Object, error) {
+	info, err := s.client.StatObject(ctx, s.bucket, s.objectName(key), minio.StatObjectOptions{})
+	if err != nil {
+		return nil, translateError(err, key)
+	}
+
+	return &Object{Key: key, Size: info.Size, ModTime: info.LastModified}, nil
+}
+
+func (s *s3Storage) Open(ctx context.Context, key string) (io.ReadSeekCloser, *Object, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, s.objectName(key), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, nil, translateError(err, key)
+	}
+
+	info, err := object.Stat()
+	if err != nil {
+		object.Close()
+		return nil, nil, translateError(err, key)
+	}
+
+	return object, &Object{Key: key, Size: info.Size, ModTime: info.LastModified}, nil
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, localPath string, contentType string) error {
+	_, err := s.client.FPutObject(ctx, s.bucket, s.objectName(key), localPath, minio.PutObjectOptions{ContentType: contentType})
+	return translateError(err, key)
+}
+
+func (s *s3Storage) Fetch(ctx context.Context, key string, localPath string) error {
+	err := s.client.FGetObject(ctx, s.bucket, s.objectName(key), localPath, minio.GetObjectOptions{})
+	return translateError(err, key)
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	err := s.client.RemoveObject(ctx, s.bucket, s.objectName(key), minio.RemoveObjectOptions{})
+	if err != nil && minio.ToErrorResponse(err).StatusCode != http.StatusNotFound {
+		return translateError(err, key)
+	}
+	return nil
+}
+
+func (s *s3Storage) DeletePrefix(ctx context.Context, prefix string) error {
+	objects := s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: s.listPrefix(prefix), Recursive: true})
+
+	for removeErr := range s.client.RemoveObjects(ctx, s.bucket, objects, minio.RemoveObjectsOptions{}) {
+		if removeErr.Err != nil {
+			return errors.Wrapf(removeErr.Err, "delete %s from S3 bucket", removeErr.ObjectName)
+		}
+	}
+
+	return nil
+}
+
+func (s *s3Storage) List(ctx context.Context, prefix string) ([]Object, error) {
+	objects := make([]Object, 0)
+	namePrefix := s.listPrefix("")
+
+	for info := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: s.listPrefix(prefix), Recursive: true}) {
+		if info.Err != nil {
+			return nil, errors.Wrap(info.Err, "list S3 bucket")
+		}
+
+		objects = append(objects, Object{
+			Key:     strings.TrimPrefix(info.Key, namePrefix),
+			Size:    info.Size,
+			ModTime: info.LastModified,
+		})
+	}
+
+	return objects, nil
+}
+
+func (s *s3Storage) PresignedURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if !s.presignedURLs {
+		return "", nil
+	}
+
+	presignedURL, err := s.client.PresignedGetObject(ctx, s.bucket, s.objectName(key), expiry, url.Values{})
+	if err != nil {
+		return "", translateError(err, key)
+	}
+
+	return presignedURL.String(), nil
+}
@@ -0,0 +1,169 @@
+// Package storage stores the files of the media cache, like thumbnails, high-res photos and encoded videos,
+// either on the local disk or in an S3-compatible object storage shared by several API replicas.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kkovaletp/photoview/api/utils"
+	"github.com/pkg/errors"
+)
+
+// Object is a file of the media cache.
+type Object struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// Storage keeps the files of the media cache. Files are identified by keys laid out as `<album id>/<media id>/<media name>`.
+// Errors for missing files satisfy `errors.Is(err, fs.ErrNotExist)`.
+type Storage interface {
+	// Stat returns the size and modification time of the file `key`.
+	Stat(ctx context.Context, key string) (*Object, error)
+	// Open returns the content of the file `key`, which must be closed by the caller.
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, *Object, error)
+	// Put stores the local file at `localPath` as `key`, replacing any previous file.
+	Put(ctx context.Context, key string, localPath string, contentType string) error
+	// Fetch copies the file `key` to the local file at `localPath`.
+	Fetch(ctx context.Context, key string, localPath string) error
+	// Delete removes the file `key`. Removing a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix removes all files under `prefix`, like `<album id>` or `<album id>/<media id>`.
+	DeletePrefix(ctx context.Context, prefix string) error
+	// List returns all files under `prefix`, or all files of the storage if it is empty.
+	List(ctx context.Context, prefix string) ([]Object, error)
+	// PresignedURL returns a URL to download the file `key` directly from the storage during `expiry`,
+	// or an empty string if the file has to be served by the API.
+	PresignedURL(ctx context.Context, key string, expiry time.Duration) (string, error)
+	// LocalPath returns where `key` is stored on the local disk, or an empty string if the storage is remote.
+	LocalPath(key string) string
+}
+
+var (
+	current      Storage = NewLocal("")
+	currentMutex sync.RWMutex
+)
+
+// Default returns the storage configured for the media cache, the local disk unless Initialize selected another one.
+func Default() Storage {
+	currentMutex.RLock()
+	defer currentMutex.RUnlock()
+	return current
+}
+
+// Configure replaces the storage of the media cache, and returns a function restoring the previous one.
+func Configure(storage Storage) func() {
+	currentMutex.Lock()
+	defer currentMutex.Unlock()
+
+	previous := current
+	current = storage
+	return func() {
+		Configure(previous)
+	}
+}
+
+// Initialize configures the storage of the media cache from the environment variables.
+func Initialize() error {
+	switch backend := strings.ToLower(utils.EnvMediaStorage.GetValue()); backend {
+	case "", "local":
+		Configure(NewLocal(""))
+	case "s3":
+		storage, err := NewS3(context.Background(), S3ConfigFromEnv())
+		if err != nil {
+			return err
+		}
+		Configure(storage)
+	default:
+		return errors.Errorf("unknown media storage %q, must be `local` or `s3`", backend)
+	}
+
+	return nil
+}
+
+// MediaPrefix returns the prefix of the keys of all files of a media.
+func MediaPrefix(albumID int, mediaID int) string {
+	return path.Join(strconv.Itoa(albumID), strconv.Itoa(mediaID))
+}
+
+// MediaKey returns the key of the file `mediaName` of a media.
+func MediaKey(albumID int, mediaID int, mediaName string) string {
+	return path.Join(MediaPrefix(albumID, mediaID), mediaName)
+}
+
+// WorkDir returns the local directory the files of a media are generated in, before being stored with Put.
+// With a local storage, it is the directory the files are stored in, so they don't have to be copied.
+// With a remote storage, it is a new temporary directory, to be removed with ReleaseWorkDir.
+func WorkDir(albumID int, mediaID int) (string, error) {
+	if workDir := Default().LocalPath(MediaPrefix(albumID, mediaID)); workDir != "" {
+		if err := os.MkdirAll(workDir, os.ModePerm); err != nil {
+			return "", errors.Wrap(err, "create cache directory of media")
+		}
+		return workDir, nil
+	}
+
+	workDir, err := os.MkdirTemp("", fmt.Sprintf("photoview-%d-%d-", albumID, mediaID))
+	if err != nil {
+		return "", errors.Wrap(err, "create work directory of media")
+	}
+
+	return workDir, nil
+}
+
+// ReleaseWorkDir removes the work directory of a media once its files are stored, unless the storage is local.
+func ReleaseWorkDir(albumID int, mediaID int, workDir string) error {
+	if Default().LocalPath(MediaPrefix(albumID, mediaID)) != "" {
+		return nil
+	}
+	return os.RemoveAll(workDir)
+}
+
+// LocalCopy returns the path of a local copy of the file `key`, and a function to release it once used.
+// The file is looked up in `workDir` first, where it is if it was just generated. A remote file is downloaded otherwise.
+func LocalCopy(ctx context.Context, key string, workDir string) (string, func(), error) {
+	noop := func() {}
+
+	if localPath := Default().LocalPath(key); localPath != "" {
+		return localPath, noop, nil
+	}
+
+	if workDir != "" {
+		workPath := filepath.Join(workDir, path.Base(key))
+		if _, err := os.Stat(workPath); err == nil {
+			return workPath, noop, nil
+		}
+	}
+
+	file, err := os.CreateTemp("", "photoview-*-"+path.Base(key))
+	if err != nil {
+		return "", noop, errors.Wrap(err, "create local copy of cached file")
+	}
+	file.Close()
+
+	release := func() {
+		os.Remove(file.Name())
+	}
+
+	if err := Default().Fetch(ctx, key, file.Name()); err != nil {
+		release()
+		return "", noop, err
+	}
+
+	return file.Name(), release, nil
+}
+
+// IsNotExist tells if `err` reports a missing file.
+func IsNotExist(err error) bool {
+	return errors.Is(err, fs.ErrNotExist)
+}
@@ -0,0 +1,220 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/johannesboyne/gofakes3"
+	"github.com/johannesboyne/gofakes3/backend/s3mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestS3 returns a storage backed by an in-memory S3 server standing in for MinIO.
+func newTestS3(t *testing.T, presignedURLs bool) Storage {
+	t.Helper()
+
+	backend := s3mem.New()
+	require.NoError(t, backend.CreateBucket("media"))
+
+	server := httptest.NewServer(gofakes3.New(backend).Server())
+	t.Cleanup(server.Close)
+
+	storage, err := NewS3(context.Background(), S3Config{
+		Endpoint:      strings.TrimPrefix(server.URL, "http://"),
+		Bucket:        "media",
+		AccessKey:     "access",
+		SecretKey:     "secret",
+		DisableTLS:    true,
+		Prefix:        "cache",
+		PresignedURLs: presignedURLs,
+	})
+	require.NoError(t, err)
+
+	return storage
+}
+
+// writeLocalFile writes a file with `content` in a temporary directory.
+func writeLocalFile(t *testing.T, name string, content string) string {
+	t.Helper()
+
+	filePath := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(filePath, []byte(content), 0644))
+	return filePath
+}
+
+// testStorage checks that `storage` behaves like every other storage.
+func testStorage(t *testing.T, storage Storage) {
+	ctx := context.Background()
+
+	photo := MediaKey(1, 10, "photo.jpg")
+	video := MediaKey(1, 11, "video.mp4")
+	other := MediaKey(2, 20, "other.jpg")
+
+	t.Run("Missing", func(t *testing.T) {
+		_, err := storage.Stat(ctx, photo)
+		assert.True(t, IsNotExist(err), "stat error: %v", err)
+
+		_, _, err = storage.Open(ctx, photo)
+		assert.True(t, IsNotExist(err), "open error: %v", err)
+
+		assert.NoError(t, storage.Delete(ctx, photo))
+	})
+
+	t.Run("PutAndRead", func(t *testing.T) {
+		require.NoError(t, storage.Put(ctx, photo, writeLocalFile(t, "photo.jpg", "photo"), "image/jpeg"))
+		require.NoError(t, storage.Put(ctx, video, writeLocalFile(t, "video.mp4", "video!"), "video/mp4"))
+		require.NoError(t, storage.Put(ctx, other, writeLocalFile(t, "other.jpg", "other"), "image/jpeg"))
+
+		object, err := storage.Stat(ctx, photo)
+		require.NoError(t, err)
+		assert.Equal(t, photo, object.Key)
+		assert.Equal(t, int64(5), object.Size)
+		assert.WithinDuration(t, time.Now(), object.ModTime, time.Minute)
+
+		reader, object, err := storage.Open(ctx, video)
+		require.NoError(t, err)
+		defer reader.Close()
+		assert.Equal(t, int64(6), object.Size)
+
+		_, err = reader.Seek(2, io.SeekStart)
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "deo!", string(content))
+
+		fetched := filepath.Join(t.TempDir(), "fetched.jpg")
+		require.NoError(t, storage.Fetch(ctx, photo, fetched))
+		assert.FileExists(t, fetched)
+		content, err = os.ReadFile(fetched)
+		require.NoError(t, err)
+		assert.Equal(t, "photo", string(content))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		objects, err := storage.List(ctx, "")
+		require.NoError(t, err)
+
+		keys := make([]string, 0, len(objects))
+		for _, object := range objects {
+			keys = append(keys, object.Key)
+		}
+		assert.ElementsMatch(t, []string{photo, video, other}, keys)
+
+		objects, err = storage.List(ctx, MediaPrefix(1, 1))
+		require.NoError(t, err)
+		assert.Empty(t, objects, "prefix must match whole path segments")
+
+		objects, err = storage.List(ctx, "1")
+		require.NoError(t, err)
+		assert.Len(t, objects, 2)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, storage.Delete(ctx, video))
+		_, err := storage.Stat(ctx, video)
+		assert.True(t, IsNotExist(err))
+
+		require.NoError(t, storage.DeletePrefix(ctx, "1"))
+		_, err = storage.Stat(ctx, photo)
+		assert.True(t, IsNotExist(err))
+
+		_, err = storage.Stat(ctx, other)
+		assert.NoError(t, err)
+	})
+}
+
+func TestLocalStorage(t *testing.T) {
+	root := t.TempDir()
+	storage := NewLocal(root)
+
+	testStorage(t, storage)
+
+	t.Run("LocalPath", func(t *testing.T) {
+		assert.Equal(t, filepath.Join(root, "2", "20", "other.jpg"), storage.LocalPath(MediaKey(2, 20, "other.jpg")))
+		assert.NoDirExists(t, filepath.Join(root, "1"), "empty album directories are removed")
+
+		url, err := storage.PresignedURL(context.Background(), MediaKey(2, 20, "other.jpg"), time.Hour)
+		assert.NoError(t, err)
+		assert.Empty(t, url)
+	})
+}
+
+func TestS3Storage(t *testing.T) {
+	testStorage(t, newTestS3(t, false))
+
+	t.Run("PresignedURL", func(t *testing.T) {
+		ctx := context.Background()
+		key := MediaKey(3, 30, "photo.jpg")
+
+		storage := newTestS3(t, false)
+		url, err := storage.PresignedURL(ctx, key, time.Hour)
+		assert.NoError(t, err)
+		assert.Empty(t, url, "presigned URLs are disabled")
+
+		storage = newTestS3(t, true)
+		url, err = storage.PresignedURL(ctx, key, time.Hour)
+		require.NoError(t, err)
+		assert.Contains(t, url, "/media/cache/3/30/photo.jpg?")
+		assert.Contains(t, url, "X-Amz-Expires=3600")
+		assert.Empty(t, storage.LocalPath(key))
+	})
+
+	t.Run("MissingBucket", func(t *testing.T) {
+		server := httptest.NewServer(gofakes3.New(s3mem.New()).Server())
+		defer server.Close()
+
+		_, err := NewS3(context.Background(), S3Config{Endpoint: strings.TrimPrefix(server.URL, "http://"), Bucket: "media", DisableTLS: true})
+		assert.ErrorContains(t, err, "does not exist")
+	})
+}
+
+func TestWorkDir(t *testing.T) {
+	root := t.TempDir()
+	defer Configure(NewLocal(root))()
+
+	workDir, err := WorkDir(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, "1", "2"), workDir)
+	assert.DirExists(t, workDir)
+
+	require.NoError(t, ReleaseWorkDir(1, 2, workDir))
+	assert.DirExists(t, workDir, "files are generated in place in a local storage")
+
+	defer Configure(newTestS3(t, false))()
+
+	workDir, err = WorkDir(1, 2)
+	require.NoError(t, err)
+	assert.NotContains(t, workDir, root)
+	assert.DirExists(t, workDir)
+
+	localPath, release, err := LocalCopy(context.Background(), MediaKey(1, 2, "missing.jpg"), workDir)
+	assert.True(t, IsNotExist(err))
+	release()
+	assert.Empty(t, localPath)
+
+	generated := filepath.Join(workDir, "thumbnail.jpg")
+	require.NoError(t, os.WriteFile(generated, []byte("thumbnail"), 0644))
+	localPath, release, err = LocalCopy(context.Background(), MediaKey(1, 2, "thumbnail.jpg"), workDir)
+	require.NoError(t, err)
+	assert.Equal(t, generated, localPath, "just generated files are used from the work directory")
+	release()
+
+	require.NoError(t, Default().Put(context.Background(), MediaKey(1, 2, "thumbnail.jpg"), generated, "image/jpeg"))
+	require.NoError(t, ReleaseWorkDir(1, 2, workDir))
+	assert.NoDirExists(t, workDir)
+
+	localPath, release, err = LocalCopy(context.Background(), MediaKey(1, 2, "thumbnail.jpg"), workDir)
+	require.NoError(t, err)
+	content, err := os.ReadFile(localPath)
+	require.NoError(t, err)
+	assert.Equal(t, "thumbnail", string(content))
+	release()
+	assert.NoFileExists(t, localPath)
+}
@@ -53,6 +53,11 @@ func IntegrationTestRun(m *testing.M) {
 	}
 	defer exifCleanup()
 
+	// The test binary can't be started as an image worker process, so photos are processed in the tests themselves
+	if err := os.Setenv(utils.EnvImageWorkers.GetName(), "-1"); err != nil {
+		log.Panicf("disable image workers error: %v", err)
+	}
+
 	terminateWorkers := executable_worker.Initialize()
 	defer terminateWorkers()
 
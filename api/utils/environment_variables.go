@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -22,6 +23,21 @@ const (
 	EnvMediaCachePath            EnvironmentVariable = "PHOTOVIEW_MEDIA_CACHE"
 	EnvFaceRecognitionModelsPath EnvironmentVariable = "PHOTOVIEW_FACE_RECOGNITION_MODELS_PATH"
 	EnvMediaProbeTimeout         EnvironmentVariable = "PHOTOVIEW_MEDIA_PROBE_TIMEOUT"
+	EnvMediaCacheMaxSize         EnvironmentVariable = "PHOTOVIEW_MEDIA_CACHE_MAX_SIZE_GB"
+	EnvMediaCacheEvictThumbnails EnvironmentVariable = "PHOTOVIEW_MEDIA_CACHE_EVICT_THUMBNAILS"
+)
+
+// Media storage
+const (
+	EnvMediaStorage    EnvironmentVariable = "PHOTOVIEW_MEDIA_STORAGE"
+	EnvS3Endpoint      EnvironmentVariable = "PHOTOVIEW_S3_ENDPOINT"
+	EnvS3Region        EnvironmentVariable = "PHOTOVIEW_S3_REGION"
+	EnvS3Bucket        EnvironmentVariable = "PHOTOVIEW_S3_BUCKET"
+	EnvS3Prefix        EnvironmentVariable = "PHOTOVIEW_S3_PREFIX"
+	EnvS3AccessKey     EnvironmentVariable = "PHOTOVIEW_S3_ACCESS_KEY"
+	EnvS3SecretKey     EnvironmentVariable = "PHOTOVIEW_S3_SECRET_KEY"
+	EnvS3DisableTLS    EnvironmentVariable = "PHOTOVIEW_S3_DISABLE_TLS"
+	EnvS3PresignedURLs EnvironmentVariable = "PHOTOVIEW_S3_PRESIGNED_URLS"
 )
 
 // Logging
@@ -54,7 +70,18 @@ const (
 	EnvDisableFaceRecognition    EnvironmentVariable = "PHOTOVIEW_DISABLE_FACE_RECOGNITION"
 	EnvDisableVideoEncoding      EnvironmentVariable = "PHOTOVIEW_DISABLE_VIDEO_ENCODING"
 	EnvDisableRawProcessing      EnvironmentVariable = "PHOTOVIEW_DISABLE_RAW_PROCESSING"
+	EnvRawEmbeddedPreview        EnvironmentVariable = "PHOTOVIEW_RAW_EMBEDDED_PREVIEW"
 	EnvVideoHardwareAcceleration EnvironmentVariable = "PHOTOVIEW_VIDEO_HARDWARE_ACCELERATION"
+	EnvVideoSpriteInterval       EnvironmentVariable = "PHOTOVIEW_VIDEO_SPRITE_INTERVAL"
+	EnvVideoPreviewLength        EnvironmentVariable = "PHOTOVIEW_VIDEO_PREVIEW_LENGTH"
+	EnvImageBackend              EnvironmentVariable = "PHOTOVIEW_IMAGE_BACKEND"
+	EnvImageWorkers              EnvironmentVariable = "PHOTOVIEW_IMAGE_WORKERS"
+	EnvImageWorkerTimeout        EnvironmentVariable = "PHOTOVIEW_IMAGE_WORKER_TIMEOUT"
+	EnvImageWorkerMemoryLimit    EnvironmentVariable = "PHOTOVIEW_IMAGE_WORKER_MEMORY_LIMIT"
+	EnvXMPWriteBack              EnvironmentVariable = "PHOTOVIEW_XMP_WRITE_BACK"
+	EnvXMPWriteBackMirrorPath    EnvironmentVariable = "PHOTOVIEW_XMP_WRITE_BACK_MIRROR_PATH"
+	EnvDateSources               EnvironmentVariable = "PHOTOVIEW_DATE_SOURCES"
+	EnvGeoNamesPath              EnvironmentVariable = "PHOTOVIEW_GEONAMES_PATH"
 )
 
 // GetName returns the name of the environment variable itself
@@ -119,6 +146,75 @@ func MediaProbeTimeout() time.Duration {
 	return 5 * time.Second
 }
 
+// VideoSpriteInterval returns the time between two frames of the scrubbing sprite sheet of a video.
+// Defaults to 10 seconds if PHOTOVIEW_VIDEO_SPRITE_INTERVAL is not set or 0.
+// A negative value disables sprite sheets, in which case 0 is returned.
+func VideoSpriteInterval() time.Duration {
+	seconds := EnvVideoSpriteInterval.GetInt()
+	if seconds < 0 {
+		return 0
+	}
+	if seconds == 0 {
+		return 10 * time.Second
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// VideoPreviewLength returns the length of the animated preview clip of a video, between 3 and 5 seconds.
+// Defaults to 4 seconds if PHOTOVIEW_VIDEO_PREVIEW_LENGTH is not set or 0, other values are clamped to the range.
+// A negative value disables preview clips, in which case 0 is returned.
+func VideoPreviewLength() time.Duration {
+	seconds := EnvVideoPreviewLength.GetInt()
+	if seconds < 0 {
+		return 0
+	}
+	if seconds == 0 {
+		return 4 * time.Second
+	}
+	return time.Duration(min(max(seconds, 3), 5)) * time.Second
+}
+
+// ImageWorkers returns the number of worker processes photos are processed in.
+// Defaults to the number of CPUs, at most 4, if PHOTOVIEW_IMAGE_WORKERS is not set or 0.
+// A negative value processes photos in the server process itself, in which case 0 is returned.
+func ImageWorkers() int {
+	workers := EnvImageWorkers.GetInt()
+	if workers < 0 {
+		return 0
+	}
+	if workers == 0 {
+		return min(runtime.NumCPU(), 4)
+	}
+	return workers
+}
+
+// ImageWorkerTimeout returns how long an image worker may take on a single photo before it is killed.
+// Defaults to 120 seconds if PHOTOVIEW_IMAGE_WORKER_TIMEOUT is not set or invalid.
+func ImageWorkerTimeout() time.Duration {
+	if seconds := EnvImageWorkerTimeout.GetInt(); seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return 120 * time.Second
+}
+
+// ImageWorkerMemoryLimit returns the memory limit in bytes of every image worker process,
+// set in megabytes by PHOTOVIEW_IMAGE_WORKER_MEMORY_LIMIT. Defaults to 0, no limit.
+func ImageWorkerMemoryLimit() int64 {
+	if megabytes := EnvImageWorkerMemoryLimit.GetInt(); megabytes > 0 {
+		return int64(megabytes) * 1024 * 1024
+	}
+	return 0
+}
+
+// MediaCacheMaxSize returns the size in bytes the media cache is limited to, set in gigabytes by PHOTOVIEW_MEDIA_CACHE_MAX_SIZE_GB.
+// Defaults to 0, no limit.
+func MediaCacheMaxSize() int64 {
+	if gigabytes := EnvMediaCacheMaxSize.GetInt(); gigabytes > 0 {
+		return int64(gigabytes) * 1024 * 1024 * 1024
+	}
+	return 0
+}
+
 // UIPath returns the value from where the static UI files are located if SERVE_UI=1
 func UIPath() string {
 	if path := EnvUIPath.GetValue(); path != "" {
@@ -2,7 +2,9 @@ package utils_test
 
 import (
 	"path/filepath"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/kkovaletp/photoview/api/utils"
 	"github.com/stretchr/testify/assert"
@@ -183,6 +185,117 @@ func TestAccessLogMaxDaysNegativeValue(t *testing.T) {
 	assert.Equal(t, 0, result, "Negative values should return default 0")
 }
 
+// =============================================================================
+// VideoSpriteInterval Tests - Default and disabled behavior
+// =============================================================================
+
+func TestVideoSpriteIntervalDefaultValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_VIDEO_SPRITE_INTERVAL", "")
+	assert.Equal(t, 10*time.Second, utils.VideoSpriteInterval())
+}
+
+func TestVideoSpriteIntervalCustomValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_VIDEO_SPRITE_INTERVAL", "4")
+	assert.Equal(t, 4*time.Second, utils.VideoSpriteInterval())
+}
+
+func TestVideoSpriteIntervalNegativeValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_VIDEO_SPRITE_INTERVAL", "-1")
+	assert.Equal(t, time.Duration(0), utils.VideoSpriteInterval())
+}
+
+// =============================================================================
+// VideoPreviewLength Tests - Default and disabled behavior
+// =============================================================================
+
+func TestVideoPreviewLengthDefaultValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_VIDEO_PREVIEW_LENGTH", "")
+	assert.Equal(t, 4*time.Second, utils.VideoPreviewLength())
+}
+
+func TestVideoPreviewLengthCustomValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_VIDEO_PREVIEW_LENGTH", "3")
+	assert.Equal(t, 3*time.Second, utils.VideoPreviewLength())
+}
+
+func TestVideoPreviewLengthClampedValue(t *testing.T) {
+	tests := map[string]time.Duration{
+		"1":  3 * time.Second,
+		"3":  3 * time.Second,
+		"5":  5 * time.Second,
+		"6":  5 * time.Second,
+		"60": 5 * time.Second,
+	}
+
+	for value, want := range tests {
+		t.Setenv("PHOTOVIEW_VIDEO_PREVIEW_LENGTH", value)
+		assert.Equal(t, want, utils.VideoPreviewLength(), "PHOTOVIEW_VIDEO_PREVIEW_LENGTH=%s", value)
+	}
+}
+
+func TestVideoPreviewLengthNegativeValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_VIDEO_PREVIEW_LENGTH", "-1")
+	assert.Equal(t, time.Duration(0), utils.VideoPreviewLength())
+}
+
+// =============================================================================
+// Image worker Tests - Default and disabled behavior
+// =============================================================================
+
+func TestImageWorkersDefaultValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_IMAGE_WORKERS", "")
+	assert.Equal(t, min(runtime.NumCPU(), 4), utils.ImageWorkers())
+}
+
+func TestImageWorkersCustomValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_IMAGE_WORKERS", "6")
+	assert.Equal(t, 6, utils.ImageWorkers())
+}
+
+func TestImageWorkersNegativeValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_IMAGE_WORKERS", "-1")
+	assert.Equal(t, 0, utils.ImageWorkers())
+}
+
+func TestImageWorkerTimeoutDefaultValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_IMAGE_WORKER_TIMEOUT", "")
+	assert.Equal(t, 120*time.Second, utils.ImageWorkerTimeout())
+}
+
+func TestImageWorkerTimeoutCustomValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_IMAGE_WORKER_TIMEOUT", "30")
+	assert.Equal(t, 30*time.Second, utils.ImageWorkerTimeout())
+}
+
+func TestImageWorkerMemoryLimitDefaultValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_IMAGE_WORKER_MEMORY_LIMIT", "")
+	assert.Equal(t, int64(0), utils.ImageWorkerMemoryLimit())
+}
+
+func TestImageWorkerMemoryLimitCustomValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_IMAGE_WORKER_MEMORY_LIMIT", "2048")
+	assert.Equal(t, int64(2048*1024*1024), utils.ImageWorkerMemoryLimit())
+}
+
+// =============================================================================
+// MediaCacheMaxSize Tests - Unlimited by default
+// =============================================================================
+
+func TestMediaCacheMaxSizeDefaultValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_MEDIA_CACHE_MAX_SIZE_GB", "")
+	assert.Equal(t, int64(0), utils.MediaCacheMaxSize())
+}
+
+func TestMediaCacheMaxSizeCustomValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_MEDIA_CACHE_MAX_SIZE_GB", "200")
+	assert.Equal(t, int64(200*1024*1024*1024), utils.MediaCacheMaxSize())
+}
+
+func TestMediaCacheMaxSizeNegativeValue(t *testing.T) {
+	t.Setenv("PHOTOVIEW_MEDIA_CACHE_MAX_SIZE_GB", "-1")
+	assert.Equal(t, int64(0), utils.MediaCacheMaxSize())
+}
+
 // =============================================================================
 // GetBool Tests - Existing function coverage
 // =============================================================================
@@ -62,6 +62,9 @@ LIBS=(
   libzstd1
   zlib1g
 
+  # libvips image backend, selected with PHOTOVIEW_IMAGE_BACKEND=vips
+  libvips-tools
+
   # go-face dependencies
   libblas3
   libdlib19.2